@@ -21,15 +21,45 @@
 //
 // See examples.sh for more complex examples (output is in testdata/output)
 //
+// The output in testdata/output is also checked by the tests of the gentstypes package, which parse the files in testdata without protoc. After changing the generator regenerate it with:
+//  go test ./gentstypes -update
+// The well-known type files are only covered if PROTOBUF_ROOT is set, as for examples.sh.
+//
 // Options
 //
 // The following options are available:
 //  declare_namespace: declare namespace for the generated type (default true)
 //  original_names: use original field names, otherwise use lowerCamelCase (default false)
 //  int_enums: use ints instead of strings for enums (default false)
-//  outpattern: control the output file paths.
+//  outpattern: control the output file paths, a Go text/template with the sprig functions and slug
 //  async_iterators: use async iterators for streaming endpoint types (default false)
 //  int64_string: use string representation for 64 bit numbers (default false)
+//  int64: representation of 64 bit numbers: number, string, bigint or string_number, overrides int64_string (default number, bigint requires json_codecs)
+//  wkt_json: use the canonical JSON representation for well-known types such as google.protobuf.Timestamp (default false)
+//  oneof_unions: generate each oneof as a union of mutually exclusive members instead of independent optional fields (default false)
+//  emit_defaults: generate fields without explicit presence (proto3 scalars without optional, repeated fields and maps) as non-optional properties, matching a marshaler with EmitDefaults set (default false)
+//  es_modules: generate ES modules instead of namespace declarations (default false)
+//  json_codecs: generate <Name>FromJSON and <Name>ToJSON functions for messages and enums (default false, requires es_modules and wkt_json)
+//  nested_namespaces: generate nested messages and enums as A.B instead of A_B (default false)
+//  json_schema: generate a JSON Schema next to each TypeScript file (default false)
+//  http_client: generate a <Service>Client class per service calling its google.api.http endpoints (default false, requires es_modules and json_codecs)
+//  grpc_web: generate a <Service>WebClient class per service calling it through a grpc-web proxy, only application/grpc-web+json is encoded (default false, requires es_modules)
+//  server_handlers: generate a <Service>Server interface per service for Node gRPC servers (default false, requires es_modules)
+//  call_options: service methods take CallOptions and return the headers, trailers and status of the call (default false, requires es_modules)
+//  status_details: declare the known google.rpc.Status detail types in the files declaring services (default false, requires es_modules)
+//  status_detail: fully qualified name of a message added to the status detail types (repeatable)
+//  any_types: type google.protobuf.Any fields as the union of the messages of the global TypeRegistry (default false, requires wkt_json)
+//  zod: generate a zod schema validating each message and enum at runtime (default false, requires es_modules)
+//  M<file>=<module>: import the types of the proto file from the module instead of generating it (requires es_modules)
+//  bundle: generate all files into a single output file with this name (default unset, requires declare_namespace)
+//  deps: also generate the files declaring types referenced by the generated files (default false)
+//  deps_allow, deps_deny: only generate dependencies in these packages and their subpackages, or skip them (repeatable)
+//  jsdoc: write comments as JSDoc blocks with @deprecated, @fieldNumber and @protoName tags (default false)
+//  enum_style: declaration of enums: enum, union, const_object or const_enum (default enum)
+//  strip_enum_prefix: remove the enum name prefix from member names, e.g. COLOR_RED of Color becomes RED (default false)
+//  enum_zeros: generate enum values of value zero (default true)
+//  input_types: follow google.api.field_behavior and generate separate <Name>Input types for requests (default false)
+//  template_dir: render headers, messages, enums and services with the *.tmpl templates in this directory (default unset)
 // An example of running with a custom option set:
 //  protoc -I. --tstypes_out=original_names=true,async_iterators=true:. route_guide.proto
 //
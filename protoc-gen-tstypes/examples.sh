@@ -17,9 +17,11 @@ echo "$PROTOBUF_ROOT"
 # This repository provide some .proto files we want like "google/api/field_behavior.proto".
 echo "$GOOGLEAPIS_ROOT"
 
+# go test ./gentstypes -update regenerates the same output without protoc.
+
 cd testdata
 rm -fr output/*
-ds=(output/defaults output/int-enums output/camel-case-names output/outpattern-{1,2,3} output/wo-namespace output/async-iterators)
+ds=(output/defaults output/int-enums output/camel-case-names output/outpattern-{1,2,3,4} output/wo-namespace output/async-iterators output/oneof-unions output/emit-defaults output/es-modules output/json-codecs output/http-client output/nested-namespaces output/int64-bigint output/json-schema output/zod output/import-mapping output/dependencies output/jsdoc output/enum-union output/enum-const-object output/enum-const-enum output/grpc-web output/call-options output/server-handlers output/input-types output/templates output/status-details output/any-types output/any-types-es-modules output/bundle output/bundle-es-modules)
 
 for proto_file in any.proto duration.proto empty.proto struct.proto timestamp.proto wrappers.proto; do
     ls -ln "$PROTOBUF_ROOT/src/google/protobuf/${proto_file}"
@@ -34,9 +36,79 @@ for e in ./*proto; do
     protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,outpattern={{.Dir}}/{{.BaseName}}.d.ts:output/outpattern-1/ "${e}"
     protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out 'v=1,outpattern={{.Descriptor.GetPackage | replace "." "/"}}/{{.BaseName}}.d.ts:output/outpattern-2/' "${e}"
     protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out 'v=1,outpattern={{.Dir}}/{{.BaseName}}pb.d.ts:output/outpattern-3/' "${e}"
+    # outpattern is executed with .Dir, .BaseName, .Descriptor, .Request, .GoPackage, .Services and .Options, slug turns
+    # RouteGuide into route-guide.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out 'v=1,wkt_json=true,es_modules=true,outpattern={{with .Options.GetJavaPackage}}{{replace "." "/" .}}{{else}}{{$.Descriptor.GetPackage | default "none" | replace "." "/"}}{{end}}/{{with .Services}}{{(index . 0).GetName | slug}}{{else}}{{$.BaseName | slug}}{{end}}.ts:output/outpattern-4/' "${e}"
     protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,declare_namespace=false:output/wo-namespace/ "${e}"
     protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,async_iterators=true:output/async-iterators/ "${e}"
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,oneof_unions=true:output/oneof-unions/ "${e}"
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,emit_defaults=true:output/emit-defaults/ "${e}"
+    # es_modules files export their types and import the types declared in other files, the default outpattern produces
+    # .ts files in this mode.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,es_modules=true:output/es-modules/ "${e}"
+    # json_codecs converts messages and enums from and to their JSON representation, google.protobuf.Timestamp fields
+    # become Dates, with millisecond precision.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,json_codecs=true:output/json-codecs/ "${e}"
+    # http_client clients call the grpc-gateway REST endpoints declared with google.api.http annotations, the fetch
+    # function used is passed to the constructor. Messages are sent and received through the JSON codecs.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,json_codecs=true,http_client=true:output/http-client/ "${e}"
+    # nested_namespaces declares nested messages and enums in a namespace merged with their parent message. Without it
+    # their names are flattened, and names that collide, such as a message A_B next to A.B, are reported as errors.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,nested_namespaces=true:output/nested-namespaces/ "${e}"
+    # int64=string_number types 64 bit numbers as string | number in requests and string otherwise. The int64 field of
+    # the opts.field and opts.field_defaults options overrides int64 per field or message, INT64_BIGINT gives strings
+    # without json_codecs.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,json_codecs=true,int64=bigint:output/int64-bigint/ "${e}"
+    # json_schema writes a JSON Schema (draft 2020-12) next to each file, e.g. routeguide.route_guide.schema.json, with the
+    # messages and enums in $defs and references to other files following outpattern.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,json_schema=true:output/json-schema/ "${e}"
+    # zod declares <Type>Schema as z.ZodType<Type>, so z.infer gives the generated type. Without json_codecs the
+    # schemas decode base64 bytes to Uint8Array, 64 bit integers follow int64.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,oneof_unions=true,zod=true:output/zod/ "${e}"
+    # M<file>=<module> imports the types of a file from a module generated with the same options, e.g.
+    # Mgoogle/type/date.proto=@example/protos/google/type/date.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,json_codecs=true,Mroute_guide.proto=@example/protos/routeguide:output/import-mapping/ "${e}"
+    # deps generates the referenced files protoc was not asked for, transitively, except M mapped ones. In bundle mode they
+    # are part of the bundle. deps_deny takes precedence over deps_allow.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,es_modules=true,deps=true,deps_deny=nested:output/dependencies/ "${e}"
+    # jsdoc also keeps detached and trailing comments, without it comments are written as // lines.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,jsdoc=true,http_client=true,es_modules=true,json_codecs=true:output/jsdoc/ "${e}"
+    # enum_style=union gives a union of the values, e.g. "RED" | "GREEN", const_object an object of the values as const with
+    # a type of its values. The values are the JSON names, or numbers with int_enums. strip_enum_prefix only applies if
+    # every value of the enum has the prefix.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,enum_style=union,enum_zeros=false:output/enum-union/ "${e}"
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,json_codecs=true,zod=true,enum_style=const_object,strip_enum_prefix=true:output/enum-const-object/ "${e}"
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,json_codecs=true,zod=true,int_enums=true,enum_style=const_enum,strip_enum_prefix=true:output/enum-const-enum/ "${e}"
+    # grpc_web clients take the fetch function and the codec of the frames, application/grpc-web+json by default. No
+    # binary protobuf codec is generated, application/grpc-web+proto needs a codec supplied by the caller. Client
+    # streaming methods are left out.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,json_codecs=true,grpc_web=true:output/grpc-web/ "${e}"
+    # call_options methods take metadata, an AbortSignal and a timeout in milliseconds and return a CallResponse, or a
+    # StreamingCallResponse. Failed calls throw a CallError with the metadata received.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,json_codecs=true,http_client=true,grpc_web=true,call_options=true:output/call-options/ "${e}"
+    # server_handlers take the request and a ServerCallContext with the metadata, deadline, cancellation signal and
+    # response headers and trailers of the call.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,server_handlers=true,input_types=true:output/server-handlers/ "${e}"
+    # input_types leaves INPUT_ONLY fields out of responses and makes OUTPUT_ONLY and IMMUTABLE fields readonly. Messages
+    # whose request shape differs get a <Name>Input type without OUTPUT_ONLY fields.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,json_codecs=true,http_client=true,zod=true,input_types=true:output/input-types/ "${e}"
+    # template_dir templates are executed with the HeaderData, MessageData, EnumData and ServiceData of the gentstypes
+    # package, header.tmpl, message.tmpl, enum.tmpl and service.tmpl replace the built-in declarations. Codecs, schemas,
+    # clients and imports are still generated.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,enum_style=union,input_types=true,template_dir=templates:output/templates/ "${e}"
+    # any_types adds the messages of each file to TypeRegistry, the (opts.field).any_types option restricts a field to the
+    # listed messages. With json_codecs and zod the codecs and schemas are registered by type URL at runtime.
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,any_types=true:output/any-types/ "${e}"
+    protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,json_codecs=true,zod=true,any_types=true:output/any-types-es-modules/ "${e}"
 done
+protos=$(ls ./*.proto | grep -v -e any.proto -e duration.proto -e empty.proto -e struct.proto -e timestamp.proto -e wrappers.proto)
+# status_details declares StatusDetailTypes, keyed by type URL, the StatusDetail union, isStatusDetail and
+# findStatusDetail, and with json_codecs statusDetailFromJSON.
+# status_detail names a message of shelves.proto, the files are generated together.
+protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,json_codecs=true,http_client=true,status_details=true,status_detail=shelves.ShelfFull:output/status-details/ ${protos}
+# bundle merges the files of each package into one namespace, an export namespace with es_modules.
+protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,bundle=protos.d.ts:output/bundle/ ${protos}
+protoc -I. -I${PROTOC_GEN_TSTYPES_ROOT} -I${PROTOBUF_ROOT} -I${GOOGLEAPIS_ROOT} --tstypes_out=v=1,wkt_json=true,es_modules=true,json_codecs=true,http_client=true,zod=true,bundle=protos.ts:output/bundle-es-modules/ ${protos}
 
 cd $PROTOC_GEN_TSTYPES_ROOT
 
@@ -46,7 +118,7 @@ cd $PROTOC_GEN_TSTYPES_ROOT
 if [ "${CHECK:-}" != "0" ]; then
     for d in ${ds[*]}; do
         set +e
-        npx tsc --lib es2015,esnext.asynciterable --strict --pretty testdata/${d}/*ts
+        npx tsc --lib es2015,esnext.asynciterable,dom,es2020 --strict --pretty testdata/${d}/*ts
         set -e
     done
 fi
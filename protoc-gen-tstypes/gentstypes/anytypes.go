@@ -0,0 +1,221 @@
+package gentstypes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/pkg/errors"
+)
+
+// With Parameters.AnyTypes each output adds its messages to the global
+// TypeRegistry interface, mapping their type URLs to their types, and
+// google.protobuf.Any fields are typed as the union of the registered
+// messages packed with their "@type". The any_types field option restricts the
+// union of a field to the listed messages. Interfaces merge, so the registry
+// holds the messages of every output the program includes. With the JSON
+// codecs the outputs also register their codecs at runtime, by type URL, and
+// the codecs of Any fields convert the messages of known types. With zod they
+// register their schemas, and the schemas of Any fields validate the packed
+// message with the schema of its type. Well-known types are left out, they
+// are packed in a "value" property.
+
+const typeRegistryName = "TypeRegistry"
+
+// anyHelpers holds the module private declarations used by the codecs of Any
+// fields and the runtime registration of codecs, they are only written to
+// files that use them. The codecs are registered on a global object shared by
+// the generated modules.
+var anyHelpers = map[string]string{
+	"typeRegistry": `const typeRegistry: { [typeURL: string]: { fromJSON(json: any): any; toJSON(m: any): any } } = (globalThis as any).protocGenTSTypesRegistry || ((globalThis as any).protocGenTSTypesRegistry = {});`,
+	"anyFromJSON": `function anyFromJSON(json: any): any {
+    const codec = typeRegistry[json["@type"]];
+    return codec ? { "@type": json["@type"], ...codec.fromJSON(json) } : json;
+}`,
+	"anyToJSON": `function anyToJSON(m: any): any {
+    const codec = typeRegistry[m["@type"]];
+    return codec ? { "@type": m["@type"], ...codec.toJSON(m) } : m;
+}`,
+	"schemaRegistry": `const schemaRegistry: { [typeURL: string]: z.ZodTypeAny } = (globalThis as any).protocGenTSTypesSchemas || ((globalThis as any).protocGenTSTypesSchemas = {});`,
+	"anySchema": `const anySchema = z.custom<` + registryUnion + `>((v: any) => {
+    const schema = typeof v === "object" && v !== null ? schemaRegistry[v["@type"]] : undefined;
+    return schema !== undefined && schema.safeParse(v).success;
+});`,
+}
+
+// registryUnion is the type of the messages of the registry packed with their
+// "@type".
+const registryUnion = `{ [K in keyof ` + typeRegistryName + `]: { "@type": K } & ` + typeRegistryName + `[K] }[keyof ` + typeRegistryName + `]`
+
+// reserveAnyNames reserves the names of the registry and its helpers.
+func (g *Generator) reserveAnyNames() {
+	g.imports.reserve(typeRegistryName)
+	for n := range anyHelpers {
+		g.imports.reserve(n)
+	}
+}
+
+func isAny(f *desc.FieldDescriptor) bool {
+	t := f.GetMessageType()
+	return t != nil && t.GetFullyQualifiedName() == "google.protobuf.Any"
+}
+
+// packedTypes returns the messages of files that may be packed in an Any
+// field with their registered type. Map entries cannot be, well-known types
+// with a special JSON mapping are packed in a "value" property.
+func (g *Generator) packedTypes(files []*desc.FileDescriptor, params *Parameters) []*desc.MessageDescriptor {
+	result := []*desc.MessageDescriptor{}
+	for _, f := range files {
+		for _, d := range fileTypes(f) {
+			m, ok := d.(*desc.MessageDescriptor)
+			if !ok || m.IsMapEntry() || g.wellKnownType(m, params) != "" {
+				continue
+			}
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// findMessage returns the message named name, fully qualified, declared in f
+// or the files it imports, or nil.
+func findMessage(f *desc.FileDescriptor, name string) *desc.MessageDescriptor {
+	seen := map[*desc.FileDescriptor]bool{}
+	var find func(f *desc.FileDescriptor) *desc.MessageDescriptor
+	find = func(f *desc.FileDescriptor) *desc.MessageDescriptor {
+		if seen[f] {
+			return nil
+		}
+		seen[f] = true
+		if m := f.FindMessage(name); m != nil {
+			return m
+		}
+		for _, dep := range f.GetDependencies() {
+			if m := find(dep); m != nil {
+				return m
+			}
+		}
+		return nil
+	}
+	return find(f)
+}
+
+// checkAnyTypes returns an error if the any_types option of a field of files
+// names a message that is not declared in its file or the files it imports,
+// or a well-known type, or is set on a field that is not an Any.
+func (g *Generator) checkAnyTypes(files []*desc.FileDescriptor, params *Parameters) error {
+	for _, f := range files {
+		for _, d := range fileTypes(f) {
+			m, ok := d.(*desc.MessageDescriptor)
+			if !ok {
+				continue
+			}
+			for _, field := range m.GetFields() {
+				names := fieldOptions(field, params).AnyTypes
+				if len(names) > 0 && !isAny(field) {
+					return errors.Errorf("%s: any_types is set on %s, which is not a google.protobuf.Any", f.GetName(), field.GetFullyQualifiedName())
+				}
+				for _, n := range names {
+					packed := findMessage(f, n)
+					if packed == nil {
+						return errors.Errorf("%s: any_types of %s: message %s not found", f.GetName(), field.GetFullyQualifiedName(), n)
+					}
+					if g.wellKnownType(packed, params) != "" {
+						return errors.Errorf("%s: any_types of %s: well-known type %s is not supported", f.GetName(), field.GetFullyQualifiedName(), n)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// anyType returns the type of the Any field f, the union of the messages its
+// any_types option lists or of the registered ones.
+func (g *Generator) anyType(f *desc.FieldDescriptor, params *Parameters) string {
+	names := fieldOptions(f, params).AnyTypes
+	if len(names) == 0 {
+		return registryUnion
+	}
+	types := []string{}
+	for _, n := range names {
+		m := findMessage(f.GetFile(), n)
+		types = append(types, fmt.Sprintf("({ \"@type\": %q } & %s)", typeURLPrefix+m.GetFullyQualifiedName(), g.typeName(m, params)))
+	}
+	return strings.Join(types, " | ")
+}
+
+// generateTypeRegistry adds the messages of files to the TypeRegistry
+// interface, declared in the global scope of modules.
+func (g *Generator) generateTypeRegistry(files []*desc.FileDescriptor, params *Parameters) {
+	types := g.packedTypes(files, params)
+	if len(types) == 0 {
+		return
+	}
+	g.W("// TypeRegistry maps the type URLs of messages to their types, each file adds")
+	g.W("// its messages.")
+	if params.ESModules {
+		g.W("declare global {")
+		g.incIndent()
+	}
+	g.W(fmt.Sprintf("interface %s {", typeRegistryName))
+	for _, m := range types {
+		g.W(indent + fmt.Sprintf("%q: %s;", typeURLPrefix+m.GetFullyQualifiedName(), g.topLevelName(m, "", params)))
+	}
+	g.W("}")
+	if params.ESModules {
+		g.decIndent()
+		g.W("}")
+	}
+	g.Buffer.WriteString("\n")
+	// Declared by the helpers for generateRegistration.
+	if params.JSONCodecs {
+		g.helper("typeRegistry")
+	}
+	if params.Zod {
+		g.helper("schemaRegistry")
+	}
+}
+
+// anySchemaExpr returns the zod schema of the Any field f, matching anyType.
+func (g *Generator) anySchemaExpr(f *desc.FieldDescriptor, params *Parameters) string {
+	names := fieldOptions(f, params).AnyTypes
+	if len(names) == 0 {
+		g.helper("schemaRegistry")
+		return g.helper("anySchema")
+	}
+	schemas := []string{}
+	for _, n := range names {
+		m := findMessage(f.GetFile(), n)
+		schemas = append(schemas, fmt.Sprintf("z.object({ \"@type\": z.literal(%q) }).and(%s)", typeURLPrefix+m.GetFullyQualifiedName(), g.codecName(m, schemaSuffix, params)))
+	}
+	if len(schemas) == 1 {
+		return schemas[0]
+	}
+	return fmt.Sprintf("z.union([%s])", strings.Join(schemas, ", "))
+}
+
+// generateRegistration registers the codecs and the schemas of the messages
+// of files by type URL. It is written after the helpers, which declare the
+// registries.
+func (g *Generator) generateRegistration(files []*desc.FileDescriptor, params *Parameters) {
+	types := g.packedTypes(files, params)
+	if len(types) == 0 {
+		return
+	}
+	if params.JSONCodecs {
+		g.W(fmt.Sprintf("Object.assign(%s, {", g.helper("typeRegistry")))
+		for _, m := range types {
+			g.W(indent + fmt.Sprintf("%q: { fromJSON: %s, toJSON: %s },", typeURLPrefix+m.GetFullyQualifiedName(),
+				g.topLevelName(m, fromJSONSuffix, params), g.topLevelName(m, toJSONSuffix, params)))
+		}
+		g.W("});")
+	}
+	if params.Zod {
+		g.W(fmt.Sprintf("Object.assign(%s, {", g.helper("schemaRegistry")))
+		for _, m := range types {
+			g.W(indent + fmt.Sprintf("%q: %s,", typeURLPrefix+m.GetFullyQualifiedName(), g.topLevelName(m, schemaSuffix, params)))
+		}
+		g.W("});")
+	}
+}
@@ -0,0 +1,43 @@
+package gentstypes
+
+import (
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+func TestCheckAnyTypes(t *testing.T) {
+	const header = `syntax = "proto3"; package a; import "opts/opts.proto"; import "google/protobuf/any.proto"; import "google/protobuf/timestamp.proto"; import "b.proto"; `
+	tests := []struct {
+		name   string
+		source string
+		err    string
+	}{
+		{"no option", `message M { google.protobuf.Any x = 1; }`, ""},
+		{"declared", `message M { google.protobuf.Any x = 1 [(opts.field).any_types = "a.N"]; } message N {}`, ""},
+		{"nested", `message M { google.protobuf.Any x = 1 [(opts.field).any_types = "a.M.N"]; message N {} }`, ""},
+		{"imported", `message M { repeated google.protobuf.Any x = 1 [(opts.field).any_types = "b.B"]; }`, ""},
+		{"not an Any", `message M { N x = 1 [(opts.field).any_types = "a.N"]; } message N {}`,
+			"a.proto: any_types is set on a.M.x, which is not a google.protobuf.Any"},
+		{"not found", `message M { google.protobuf.Any x = 1 [(opts.field).any_types = "a.Missing"]; }`,
+			"a.proto: any_types of a.M.x: message a.Missing not found"},
+		{"unqualified", `message M { google.protobuf.Any x = 1 [(opts.field).any_types = "N"]; } message N {}`,
+			"a.proto: any_types of a.M.x: message N not found"},
+		{"well-known type", `message M { google.protobuf.Any x = 1 [(opts.field).any_types = "google.protobuf.Timestamp"]; }`,
+			"a.proto: any_types of a.M.x: well-known type google.protobuf.Timestamp is not supported"},
+	}
+	params := &Parameters{WellKnownTypesAsJSON: true, AnyTypes: true}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := parseFiles(t, map[string]string{
+				"a.proto": header + tt.source,
+				"b.proto": `syntax = "proto3"; package b; message B {}`,
+			})
+			for _, f := range files {
+				if f.GetName() == "a.proto" {
+					checkError(t, New().checkAnyTypes([]*desc.FileDescriptor{f}, params), tt.err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package gentstypes
+
+import (
+	"fmt"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+// With Parameters.CallOptions methods take a CallOptions argument after the
+// request, with the metadata sent as request headers, a cancellation signal
+// and a timeout, and return the response wrapped with the headers, trailers
+// and status of the call. Streams are AsyncIterables whatever the
+// AsyncIterators parameter. The clients take the options too, their call
+// helpers fill in the headers, trailers and status of a meta object. Failed
+// calls throw a CallError holding them.
+
+// callHelpers holds the declarations used by methods with call options, they
+// are only written to files that use them. The types are exported, callers
+// refer to them.
+var callHelpers = map[string]string{
+	"CallOptions": `export interface CallOptions {
+    // metadata is sent as request headers.
+    metadata?: { [key: string]: string | Array<string> };
+    // signal cancels the call when aborted.
+    signal?: AbortSignal;
+    // timeout is the deadline of the call in milliseconds.
+    timeout?: number;
+}`,
+	"Metadata": `export type Metadata = { [key: string]: Array<string> };`,
+	"CallStatus": `// CallStatus is the google.rpc.Status of a call.
+export interface CallStatus {
+    // code is a google.rpc.Code, 0 if the call succeeded.
+    code: number;
+    message: string;
+    // details are google.protobuf.Any messages in their JSON representation,
+    // those of grpc-web calls hold the message encoded in base64 as value.
+    details: Array<{ "@type": string; [key: string]: any }>;
+}`,
+	"CallError": `// CallError is thrown by failed calls, with the metadata received.
+export interface CallError extends Error {
+    headers: Metadata;
+    trailers: Metadata;
+    callStatus: CallStatus;
+}`,
+	"CallResponse": `export interface CallResponse<T> {
+    response: T;
+    headers: Metadata;
+    trailers: Metadata;
+    status: CallStatus;
+}`,
+	"StreamingCallResponse": `export interface StreamingCallResponse<T> {
+    responses: AsyncIterable<T>;
+    // headers, trailers and status resolve once the responses are read.
+    headers: Promise<Metadata>;
+    trailers: Promise<Metadata>;
+    status: Promise<CallStatus>;
+}`,
+	"streamingCall": `function streamingCall<T>(start: (meta: any) => AsyncGenerator<any>, decode: (m: any) => T): StreamingCallResponse<T> {
+    const meta: any = {};
+    let settle = () => {};
+    const done = new Promise<void>((resolve) => settle = resolve);
+    async function* responses(): AsyncGenerator<T> {
+        try {
+            for await (const m of start(meta)) {
+                yield decode(m);
+            }
+        } catch (e) {
+            throw callError(e, meta);
+        } finally {
+            meta.status = meta.status || { code: 1, message: "cancelled", details: [] };
+            settle();
+        }
+    }
+    return {
+        responses: responses(),
+        headers: done.then(() => meta.headers || {}),
+        trailers: done.then(() => meta.trailers || {}),
+        status: done.then(() => meta.status),
+    };
+}`,
+	"callError": `function callError(e: any, meta: any): CallError {
+    // Errors thrown before the status is received, or after an OK one, give
+    // the status.
+    if (!meta.status || meta.status.code === 0) {
+        const code = e?.name === "TimeoutError" ? 4 : e?.name === "AbortError" ? 1 : typeof e?.code === "number" ? e.code : 2;
+        meta.status = { code: code, message: String(e?.message ?? e), details: [] };
+    }
+    return Object.assign(e, { headers: meta.headers || {}, trailers: meta.trailers || {}, callStatus: meta.status });
+}`,
+	"callHeaders": `function callHeaders(opts: any): { [key: string]: string } {
+    const headers: { [key: string]: string } = {};
+    const metadata = opts.metadata || {};
+    for (const k of Object.keys(metadata)) {
+        headers[k] = ([] as Array<string>).concat(metadata[k]).join(", ");
+    }
+    if (opts.timeout !== undefined) {
+        headers["grpc-timeout"] = Math.ceil(opts.timeout) + "m";
+    }
+    return headers;
+}`,
+	"callSignal": `function callSignal(opts: any): AbortSignal | undefined {
+    if (opts.timeout === undefined) {
+        return opts.signal;
+    }
+    const timeout = AbortSignal.timeout(opts.timeout);
+    if (!opts.signal) {
+        return timeout;
+    }
+    const c = new AbortController();
+    for (const s of [opts.signal, timeout]) {
+        if (s.aborted) {
+            c.abort(s.reason);
+        } else {
+            s.addEventListener("abort", () => c.abort(s.reason));
+        }
+    }
+    return c.signal;
+}`,
+	"callMetadata": `function callMetadata(headers: Headers, prefix: string): { [key: string]: Array<string> } {
+    const metadata: { [key: string]: Array<string> } = {};
+    headers.forEach((v, k) => {
+        if (k.startsWith(prefix)) {
+            metadata[k.slice(prefix.length)] = v.split(", ");
+        }
+    });
+    return metadata;
+}`,
+}
+
+// reserveCallNames reserves the names of the helpers used by methods with
+// call options and by the clients.
+func (g *Generator) reserveCallNames() {
+	for n := range callHelpers {
+		g.imports.reserve(n)
+	}
+}
+
+// callSignature returns the member of the service interface declaring method
+// with call options.
+func (g *Generator) callSignature(method *desc.MethodDescriptor, params *Parameters) string {
+	i := g.inputTypeName(method.GetInputType(), params)
+	if method.IsClientStreaming() {
+		i = fmt.Sprintf("AsyncIterable<%s>", i)
+	}
+	return fmt.Sprintf("%s: (r: %s, opts?: %s) => %s;", method.GetName(), i, g.helper("CallOptions"), g.callResult(method, params))
+}
+
+// callResult returns the type of the result of method with call options.
+func (g *Generator) callResult(method *desc.MethodDescriptor, params *Parameters) string {
+	g.helper("Metadata")
+	g.helper("CallStatus")
+	g.helper("CallError")
+	o := g.typeName(method.GetOutputType(), params)
+	if method.IsServerStreaming() {
+		return fmt.Sprintf("%s<%s>", g.helper("StreamingCallResponse"), o)
+	}
+	return fmt.Sprintf("Promise<%s<%s>>", g.helper("CallResponse"), o)
+}
+
+// generateCallMethod writes the client method calling method with call
+// options. call is the expression starting the call, with meta in scope, and
+// result the response converted from m. unary wraps the call of a unary
+// method to give its response.
+func (g *Generator) generateCallMethod(method *desc.MethodDescriptor, call, result, unary string, params *Parameters) {
+	in := g.inputTypeName(method.GetInputType(), params)
+	async := "async "
+	if method.IsServerStreaming() {
+		async = ""
+	}
+	g.wdoc(method, params)
+	g.W(fmt.Sprintf("%s%s(r: %s, opts?: %s): %s {", async, method.GetName(), in, g.helper("CallOptions"), g.callResult(method, params)))
+	g.helper("callError")
+	if method.IsServerStreaming() {
+		g.W(indent + fmt.Sprintf("return %s((meta) => %s, (m) => %s);", g.helper("streamingCall"), call, result))
+		g.W("}")
+		return
+	}
+	g.W(indent + "const meta: any = {};")
+	g.W(indent + "try {")
+	g.W(indent + indent + fmt.Sprintf("const m = await %s;", fmt.Sprintf(unary, call)))
+	g.W(indent + indent + fmt.Sprintf("return %s;", callResponse(result)))
+	g.W(indent + "} catch (e) {")
+	g.W(indent + indent + "throw callError(e, meta);")
+	g.W(indent + "}")
+	g.W("}")
+}
+
+// callResponse returns the CallResponse literal of the response result and
+// the metadata in meta.
+func callResponse(result string) string {
+	return fmt.Sprintf("{ response: %s, headers: meta.headers, trailers: meta.trailers, status: meta.status }", result)
+}
@@ -0,0 +1,37 @@
+package gentstypes
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCallOptions(t *testing.T) {
+	const header = `syntax = "proto3"; package c; message M {} `
+	tests := []struct {
+		name   string
+		method string
+		want   []string
+	}{
+		{"unary", `rpc Get(M) returns (M);`,
+			[]string{"Get: (r: M, opts?: CallOptions) => Promise<CallResponse<M>>;", "async Get(r: M, opts?: CallOptions): Promise<CallResponse<M>> {"}},
+		{"server streaming", `rpc Watch(M) returns (stream M);`,
+			[]string{"Watch: (r: M, opts?: CallOptions) => StreamingCallResponse<M>;", "Watch(r: M, opts?: CallOptions): StreamingCallResponse<M> {"}},
+		{"client streaming", `rpc Upload(stream M) returns (M);`,
+			[]string{"Upload: (r: AsyncIterable<M>, opts?: CallOptions) => Promise<CallResponse<M>>;"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &Parameters{ESModules: true, GRPCWeb: true, CallOptions: true, OutputNamePattern: "{{.BaseName}}.ts"}
+			files, err := generate(t, map[string]string{"c.proto": header + "service S { " + tt.method + " }"}, params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := files["c.ts"]
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("output does not contain %q:\n%s", w, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package gentstypes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// The JSON codecs convert between the proto3 JSON encoding and the generated
+// types. Decoding accepts both the original and the lowerCamelCase field
+// names as well as enum names and numbers, encoding writes the field names
+// selected by Parameters.OriginalNames.
+
+const (
+	fromJSONSuffix = "FromJSON"
+	toJSONSuffix   = "ToJSON"
+)
+
+// codecHelpers holds the module private functions used by the generated
+// codecs, they are only written to files that use them.
+var codecHelpers = map[string]string{
+	"jsonField": `function jsonField(json: any, name: string, jsonName: string): any {
+    return json[name] !== undefined ? json[name] : json[jsonName];
+}`,
+	"boolFromJSON": `function boolFromJSON(json: any): boolean {
+    return json === true || json === "true";
+}`,
+	"numberToJSON": `function numberToJSON(n: number): number | string {
+    return isFinite(n) ? n : String(n);
+}`,
+	"bytesFromJSON": `function bytesFromJSON(json: any): Uint8Array {
+    const s = atob(String(json).replace(/-/g, "+").replace(/_/g, "/"));
+    const b = new Uint8Array(s.length);
+    for (let i = 0; i < s.length; i++) {
+        b[i] = s.charCodeAt(i);
+    }
+    return b;
+}`,
+	"bytesToJSON": `function bytesToJSON(b: Uint8Array): string {
+    let s = "";
+    for (let i = 0; i < b.length; i++) {
+        s += String.fromCharCode(b[i]);
+    }
+    return btoa(s);
+}`,
+	"mapFromJSON": `function mapFromJSON<T>(json: any, f: (v: any) => T): { [key: string]: T } {
+    const m: { [key: string]: T } = {};
+    for (const k of Object.keys(json)) {
+        m[k] = f(json[k]);
+    }
+    return m;
+}`,
+	"mapToJSON": `function mapToJSON<T>(m: { [key: string]: T }, f: (v: T) => any): { [key: string]: any } {
+    const json: { [key: string]: any } = {};
+    for (const k of Object.keys(m)) {
+        json[k] = f(m[k]);
+    }
+    return json;
+}`,
+}
+
+// reserveCodecNames reserves the names of the codec functions and helpers
+// declared by the output of files.
+func (g *Generator) reserveCodecNames(files []*desc.FileDescriptor) {
+	for _, f := range files {
+		for _, d := range fileTypes(f) {
+			g.imports.reserve(packageQualifiedName(d) + fromJSONSuffix)
+			g.imports.reserve(packageQualifiedName(d) + toJSONSuffix)
+		}
+	}
+	for n := range codecHelpers {
+		g.imports.reserve(n)
+	}
+}
+
+// helper returns the name of the codec, schema or client helper and records
+// that the file being generated uses it.
+func (g *Generator) helper(name string) string {
+	g.helpers[name] = true
+	return name
+}
+
+// generateHelpers writes the codec, schema and client helpers used by the file
+// being generated.
+func (g *Generator) generateHelpers() {
+	names := []string{}
+	for n := range g.helpers {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	if len(names) > 0 && !strings.HasSuffix(g.String(), "\n\n") {
+		g.Buffer.WriteString("\n")
+	}
+	for _, n := range names {
+		for _, helpers := range []map[string]string{codecHelpers, zodHelpers, httpHelpers, grpcWebHelpers, serverHelpers, callHelpers, anyHelpers} {
+			if src, ok := helpers[n]; ok {
+				g.W(src + "\n")
+				break
+			}
+		}
+	}
+}
+
+// codecName returns the name the codec function or schema of the message or
+// enum d is referred to by in the file being generated.
+func (g *Generator) codecName(d desc.Descriptor, suffix string, params *Parameters) string {
+	if !g.files[d.GetFile()] {
+		return g.importName(d, suffix, true, params)
+	}
+	return g.localName(d, suffix, params)
+}
+
+func (g *Generator) generateEnumCodecs(e *desc.EnumDescriptor, params *Parameters) {
+	name := declName(e, params)
+	g.W(fmt.Sprintf("export function %s%s(json: any): %s {", name, fromJSONSuffix, name))
+	g.W(indent + "switch (json) {")
+	for _, v := range enumValues(e, params) {
+		g.W(indent + fmt.Sprintf("case %d:", v.GetNumber()))
+		g.W(indent + fmt.Sprintf("case \"%s\":", v.GetName()))
+		g.W(indent + indent + fmt.Sprintf("return %s;", enumValueRef(name, v, params)))
+	}
+	g.W(indent + "}")
+	g.W(indent + "return json;")
+	g.W("}\n")
+
+	g.W(fmt.Sprintf("export function %s%s(e: %s): string {", name, toJSONSuffix, name))
+	g.W(indent + "switch (e) {")
+	seen := map[int32]bool{}
+	for _, v := range enumValues(e, params) {
+		// With allow_alias several names share a number, the first one is
+		// the canonical name.
+		if seen[v.GetNumber()] {
+			continue
+		}
+		seen[v.GetNumber()] = true
+		g.W(indent + fmt.Sprintf("case %s:", enumValueRef(name, v, params)))
+		g.W(indent + indent + fmt.Sprintf("return \"%s\";", v.GetName()))
+	}
+	g.W(indent + "}")
+	g.W(indent + "return String(e);")
+	g.W("}\n")
+}
+
+func (g *Generator) generateMessageCodecs(m *desc.MessageDescriptor, params *Parameters) {
+	name := declName(m, params)
+	fields := g.shapeFields(m, params)
+	g.W(fmt.Sprintf("export function %s%s(json: any): %s {", name, fromJSONSuffix, name))
+	g.W(indent + "const m: any = {};")
+	if len(fields) > 0 {
+		g.W(indent + "let v: any;")
+	}
+	for _, f := range fields {
+		lookup := fmt.Sprintf("json[\"%s\"]", f.GetName())
+		if f.GetJSONName() != f.GetName() {
+			lookup = fmt.Sprintf("%s(json, \"%s\", \"%s\")", g.helper("jsonField"), f.GetName(), f.GetJSONName())
+		}
+		// A JSON null means the field is unset, except for
+		// google.protobuf.Value where it is the null value.
+		cond := "!= null"
+		if t := f.GetMessageType(); t != nil && t.GetFullyQualifiedName() == "google.protobuf.Value" && !f.IsRepeated() {
+			cond = "!== undefined"
+		}
+		g.W(indent + fmt.Sprintf("if ((v = %s) %s) {", lookup, cond))
+		g.W(indent + indent + fmt.Sprintf("m.%s = %s;", fieldName(f, params), g.fromJSON(f, "v", params)))
+		g.W(indent + "}")
+	}
+	g.W(indent + fmt.Sprintf("return m as %s;", name))
+	g.W("}\n")
+
+	g.generateMessageToJSON(m, name, params)
+	// Requests are only encoded, the input shape has no FromJSON function.
+	if needsInput(m, params) {
+		g.inputShape = true
+		g.generateMessageToJSON(m, name+inputSuffix, params)
+		g.inputShape = false
+	}
+}
+
+// generateMessageToJSON writes the ToJSON function of the shape of m named
+// name.
+func (g *Generator) generateMessageToJSON(m *desc.MessageDescriptor, name string, params *Parameters) {
+	g.W(fmt.Sprintf("export function %s%s(m: %s): any {", name, toJSONSuffix, name))
+	g.W(indent + "const json: any = {};")
+	for _, f := range g.shapeFields(m, params) {
+		n := fieldName(f, params)
+		g.W(indent + fmt.Sprintf("if (m.%s !== undefined) {", n))
+		g.W(indent + indent + fmt.Sprintf("json[\"%s\"] = %s;", n, g.toJSON(f, "m."+n, params)))
+		g.W(indent + "}")
+	}
+	g.W(indent + "return json;")
+	g.W("}\n")
+}
+
+// fromJSON returns an expression converting the JSON value v of the field f.
+func (g *Generator) fromJSON(f *desc.FieldDescriptor, v string, params *Parameters) string {
+	if f.IsMap() {
+		return fmt.Sprintf("%s(%s, (e: any) => %s)", g.helper("mapFromJSON"), v, g.valueFromJSON(f.GetMapValueType(), "e", params))
+	}
+	if f.IsRepeated() {
+		return fmt.Sprintf("%s.map((e: any) => %s)", v, g.valueFromJSON(f, "e", params))
+	}
+	return g.valueFromJSON(f, v, params)
+}
+
+// valueFromJSON returns an expression converting the JSON value v of a single
+// element of the field f.
+func (g *Generator) valueFromJSON(f *desc.FieldDescriptor, v string, params *Parameters) string {
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return int64Representation(f, params).fromJSON(v)
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return fmt.Sprintf("%s(%s)", g.helper("boolFromJSON"), v)
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return fmt.Sprintf("String(%s)", v)
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return fmt.Sprintf("%s(%s)", g.helper("bytesFromJSON"), v)
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return fmt.Sprintf("%s(%s)", g.codecName(f.GetEnumType(), fromJSONSuffix, params), v)
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		t := f.GetMessageType()
+		switch t.GetFullyQualifiedName() {
+		case "google.protobuf.Timestamp":
+			// Dates hold milliseconds, the digits of finer timestamps are
+			// dropped and not encoded back.
+			return fmt.Sprintf("new Date(%s)", v)
+		case "google.protobuf.Any":
+			if params.AnyTypes {
+				g.helper("typeRegistry")
+				return fmt.Sprintf("%s(%s)", g.helper("anyFromJSON"), v)
+			}
+			return v
+		case "google.protobuf.Duration", "google.protobuf.FieldMask":
+			return fmt.Sprintf("String(%s)", v)
+		case "google.protobuf.Struct", "google.protobuf.Value", "google.protobuf.ListValue",
+			"google.protobuf.Empty":
+			return v
+		}
+		if isWrapperType(t) {
+			if w := t.FindFieldByName("value"); isInt64(w) {
+				return int64Representation(f, params).fromJSON(v)
+			}
+			return g.valueFromJSON(t.FindFieldByName("value"), v, params)
+		}
+		return fmt.Sprintf("%s(%s)", g.codecName(t, fromJSONSuffix, params), v)
+	}
+	// Remaining types are numbers, which may be encoded as strings.
+	return fmt.Sprintf("Number(%s)", v)
+}
+
+// toJSON returns an expression converting the value v of the field f to JSON.
+func (g *Generator) toJSON(f *desc.FieldDescriptor, v string, params *Parameters) string {
+	if f.IsMap() {
+		return fmt.Sprintf("%s(%s, (e: any) => %s)", g.helper("mapToJSON"), v, g.valueToJSON(f.GetMapValueType(), "e", params))
+	}
+	if f.IsRepeated() {
+		return fmt.Sprintf("%s.map((e: any) => %s)", v, g.valueToJSON(f, "e", params))
+	}
+	return g.valueToJSON(f, v, params)
+}
+
+// valueToJSON returns an expression converting the value v of a single
+// element of the field f to JSON.
+func (g *Generator) valueToJSON(f *desc.FieldDescriptor, v string, params *Parameters) string {
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE, descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return fmt.Sprintf("%s(%s)", g.helper("numberToJSON"), v)
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return fmt.Sprintf("String(%s)", v)
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return fmt.Sprintf("%s(%s)", g.helper("bytesToJSON"), v)
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return fmt.Sprintf("%s(%s)", g.codecName(f.GetEnumType(), toJSONSuffix, params), v)
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		t := f.GetMessageType()
+		switch t.GetFullyQualifiedName() {
+		case "google.protobuf.Timestamp":
+			return fmt.Sprintf("%s.toISOString()", v)
+		case "google.protobuf.Any":
+			if params.AnyTypes {
+				g.helper("typeRegistry")
+				return fmt.Sprintf("%s(%s)", g.helper("anyToJSON"), v)
+			}
+			return v
+		case "google.protobuf.Duration", "google.protobuf.FieldMask",
+			"google.protobuf.Struct", "google.protobuf.Value", "google.protobuf.ListValue",
+			"google.protobuf.Empty":
+			return v
+		}
+		if isWrapperType(t) {
+			return fmt.Sprintf("%s === null ? null : %s", v, g.valueToJSON(t.FindFieldByName("value"), v, params))
+		}
+		if g.inputShape && needsInput(t, params) {
+			return fmt.Sprintf("%s(%s)", g.codecName(t, inputSuffix+toJSONSuffix, params), v)
+		}
+		return fmt.Sprintf("%s(%s)", g.codecName(t, toJSONSuffix, params), v)
+	}
+	return v
+}
@@ -0,0 +1,89 @@
+package gentstypes
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+// dependencyFiles returns the files not in files declaring the messages and
+// enums referenced by files, directly or through other dependencies. Files
+// mapped by the import map, and files of packages rejected by the allow and
+// deny lists of params, are left out along with their own dependencies.
+func dependencyFiles(files []*desc.FileDescriptor, params *Parameters) []*desc.FileDescriptor {
+	seen := map[*desc.FileDescriptor]bool{}
+	for _, f := range files {
+		seen[f] = true
+	}
+	result := []*desc.FileDescriptor{}
+	var visit func(f *desc.FileDescriptor)
+	use := func(d desc.Descriptor) {
+		f := d.GetFile()
+		if seen[f] {
+			return
+		}
+		seen[f] = true
+		if _, ok := params.ImportMap[f.GetName()]; ok || !dependencyAllowed(f.GetPackage(), params) {
+			if params.Verbose > 0 {
+				fmt.Fprintln(os.Stderr, "skipping dependency", f.GetName())
+			}
+			return
+		}
+		result = append(result, f)
+		visit(f)
+	}
+	visit = func(f *desc.FileDescriptor) {
+		for _, d := range fileTypes(f) {
+			m, ok := d.(*desc.MessageDescriptor)
+			if !ok {
+				continue
+			}
+			for _, field := range m.GetFields() {
+				if e := field.GetEnumType(); e != nil {
+					use(e)
+				}
+				if t := field.GetMessageType(); t != nil && !hasJSONRepresentation(t, params) {
+					use(t)
+				}
+			}
+		}
+		for _, svc := range f.GetServices() {
+			for _, method := range svc.GetMethods() {
+				use(method.GetInputType())
+				use(method.GetOutputType())
+			}
+		}
+	}
+	for _, f := range files {
+		visit(f)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].GetName() < result[j].GetName() })
+	return result
+}
+
+// hasJSONRepresentation reports whether fields of the well-known type m are
+// generated as its JSON representation rather than as a reference to m.
+func hasJSONRepresentation(m *desc.MessageDescriptor, params *Parameters) bool {
+	return params.WellKnownTypesAsJSON && (isWrapperType(m) || wellKnownTypeSchema(m) != nil)
+}
+
+// dependencyAllowed reports whether dependencies in the package pkg are
+// generated. A package matches the packages listed in params and their
+// subpackages, the deny list takes precedence.
+func dependencyAllowed(pkg string, params *Parameters) bool {
+	matches := func(list []string) bool {
+		for _, p := range list {
+			if pkg == p || strings.HasPrefix(pkg, p+".") {
+				return true
+			}
+		}
+		return false
+	}
+	if matches(params.DependencyDeny) {
+		return false
+	}
+	return len(params.DependencyAllow) == 0 || matches(params.DependencyAllow)
+}
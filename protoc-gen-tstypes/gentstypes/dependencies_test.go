@@ -0,0 +1,31 @@
+package gentstypes
+
+import "testing"
+
+func TestDependencyAllowed(t *testing.T) {
+	tests := []struct {
+		pkg   string
+		allow []string
+		deny  []string
+		want  bool
+	}{
+		{"google.api", nil, nil, true},
+		{"", nil, nil, true},
+		{"google.api", []string{"google"}, nil, true},
+		{"google.api", []string{"google.api"}, nil, true},
+		{"google.apis", []string{"google.api"}, nil, false},
+		{"googleapis", []string{"google"}, nil, false},
+		{"example", []string{"google", "example"}, nil, true},
+		{"google.rpc", nil, []string{"google.api"}, true},
+		{"google.api.expr", nil, []string{"google.api"}, false},
+		{"google.api", []string{"google"}, []string{"google.api"}, false},
+		{"google.api", []string{"google.api"}, []string{"google"}, false},
+		{"", []string{"google"}, nil, false},
+	}
+	for _, tt := range tests {
+		params := &Parameters{DependencyAllow: tt.allow, DependencyDeny: tt.deny}
+		if got := dependencyAllowed(tt.pkg, params); got != tt.want {
+			t.Errorf("dependencyAllowed(%q) with allow %v and deny %v = %v, want %v", tt.pkg, tt.allow, tt.deny, got, tt.want)
+		}
+	}
+}
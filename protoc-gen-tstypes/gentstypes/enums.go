@@ -0,0 +1,102 @@
+package gentstypes
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/pkg/errors"
+)
+
+// EnumStyle selects how enums are declared. Values are the enum value names,
+// or numbers with EnumsAsInt, in every style.
+type EnumStyle string
+
+const (
+	// EnumStyleEnum declares a TypeScript enum.
+	EnumStyleEnum EnumStyle = "enum"
+	// EnumStyleUnion declares a union of the values, without runtime
+	// representation or member names.
+	EnumStyleUnion EnumStyle = "union"
+	// EnumStyleConstObject declares an object with the values as const and
+	// a type of the same name for its values.
+	EnumStyleConstObject EnumStyle = "const_object"
+	// EnumStyleConstEnum declares a const enum, whose members are inlined.
+	EnumStyleConstEnum EnumStyle = "const_enum"
+)
+
+// ParseEnumStyle parses the name of an EnumStyle.
+func ParseEnumStyle(s string) (EnumStyle, error) {
+	switch st := EnumStyle(s); st {
+	case EnumStyleEnum, EnumStyleUnion, EnumStyleConstObject, EnumStyleConstEnum:
+		return st, nil
+	}
+	return "", errors.Errorf("unknown enum style %q, expected one of enum, union, const_object or const_enum", s)
+}
+
+// enumValues returns the values of e that are generated, the zero value is
+// left out with OmitEnumZeros.
+func enumValues(e *desc.EnumDescriptor, params *Parameters) []*desc.EnumValueDescriptor {
+	result := []*desc.EnumValueDescriptor{}
+	for _, v := range e.GetValues() {
+		if params.OmitEnumZeros && v.GetNumber() == 0 {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// enumValueLiteral returns the value of v, its name or number.
+func enumValueLiteral(v *desc.EnumValueDescriptor, params *Parameters) string {
+	if params.EnumsAsInt {
+		return fmt.Sprint(v.GetNumber())
+	}
+	return fmt.Sprintf("%q", v.GetName())
+}
+
+// enumValueRef returns an expression for the value v of the enum named name.
+// Unions have no members to refer to.
+func enumValueRef(name string, v *desc.EnumValueDescriptor, params *Parameters) string {
+	if params.EnumStyle == EnumStyleUnion {
+		return enumValueLiteral(v, params)
+	}
+	return name + "." + enumMemberName(v, params)
+}
+
+// enumMemberName returns the member name of v. With StripEnumPrefix the
+// prefix made of the enum name in upper snake case is removed, as in
+// COLOR_RED of the enum Color, if all values of the enum have it.
+func enumMemberName(v *desc.EnumValueDescriptor, params *Parameters) string {
+	if !params.StripEnumPrefix {
+		return v.GetName()
+	}
+	e := v.GetEnum()
+	prefix := upperSnakeCase(e.GetName()) + "_"
+	for _, o := range e.GetValues() {
+		rest := strings.TrimPrefix(o.GetName(), prefix)
+		if rest == o.GetName() || rest == "" || !(unicode.IsLetter(rune(rest[0])) || rest[0] == '_') {
+			return v.GetName()
+		}
+	}
+	return strings.TrimPrefix(v.GetName(), prefix)
+}
+
+// upperSnakeCase converts the CamelCase name s to UPPER_SNAKE_CASE, e.g.
+// HTTPMethod to HTTP_METHOD.
+func upperSnakeCase(s string) string {
+	rs := []rune(s)
+	b := strings.Builder{}
+	for i, r := range rs {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := rs[i-1]
+			nextLower := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
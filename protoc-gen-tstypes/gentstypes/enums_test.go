@@ -0,0 +1,71 @@
+package gentstypes
+
+import "testing"
+
+func TestParseEnumStyle(t *testing.T) {
+	tests := []struct {
+		in   string
+		want EnumStyle
+		err  string
+	}{
+		{"enum", EnumStyleEnum, ""},
+		{"union", EnumStyleUnion, ""},
+		{"const_object", EnumStyleConstObject, ""},
+		{"const_enum", EnumStyleConstEnum, ""},
+		{"", "", `unknown enum style ""`},
+		{"Union", "", `unknown enum style "Union"`},
+	}
+	for _, tt := range tests {
+		got, err := ParseEnumStyle(tt.in)
+		checkError(t, err, tt.err)
+		if got != tt.want {
+			t.Errorf("ParseEnumStyle(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestUpperSnakeCase(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Color", "COLOR"},
+		{"TrafficLight", "TRAFFIC_LIGHT"},
+		{"HTTPMethod", "HTTP_METHOD"},
+		{"Version2Kind", "VERSION2_KIND"},
+		{"ABC", "ABC"},
+	}
+	for _, tt := range tests {
+		if got := upperSnakeCase(tt.in); got != tt.want {
+			t.Errorf("upperSnakeCase(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestEnumMemberName(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []string
+	}{
+		{"prefixed", `enum Color { COLOR_UNSPECIFIED = 0; COLOR_RED = 1; }`, []string{"UNSPECIFIED", "RED"}},
+		{"acronym", `enum HTTPMethod { HTTP_METHOD_UNSPECIFIED = 0; HTTP_METHOD_GET = 1; }`, []string{"UNSPECIFIED", "GET"}},
+		{"partial prefix", `enum Color { COLOR_UNSPECIFIED = 0; RED = 1; }`, []string{"COLOR_UNSPECIFIED", "RED"}},
+		{"prefix of the name only", `enum Color { COLORLESS = 0; COLOR_RED = 1; }`, []string{"COLORLESS", "COLOR_RED"}},
+		{"digit after the prefix", `enum Color { COLOR_UNSPECIFIED = 0; COLOR_1 = 1; }`, []string{"COLOR_UNSPECIFIED", "COLOR_1"}},
+		{"nothing after the prefix", `enum Color { COLOR_ = 0; COLOR_RED = 1; }`, []string{"COLOR_", "COLOR_RED"}},
+		{"underscore after the prefix", `enum Color { COLOR__A = 0; COLOR_RED = 1; }`, []string{"_A", "RED"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := parseFiles(t, map[string]string{"e.proto": `syntax = "proto3"; package e; ` + tt.source})
+			for i, v := range files[0].GetEnumTypes()[0].GetValues() {
+				if got := enumMemberName(v, &Parameters{StripEnumPrefix: true}); got != tt.want[i] {
+					t.Errorf("member name of %s = %q, want %q", v.GetName(), got, tt.want[i])
+				}
+				if got := enumMemberName(v, &Parameters{}); got != v.GetName() {
+					t.Errorf("member name of %s without strip_enum_prefix = %q", v.GetName(), got)
+				}
+			}
+		})
+	}
+}
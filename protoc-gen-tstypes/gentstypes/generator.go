@@ -1,17 +1,14 @@
 package gentstypes
 
-// TODO: add nested messages support
-// TODO: add nested enum support
-
 import (
 	"bytes"
 	"fmt"
-	"html/template"
-	"log"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"text/template"
+	"unicode"
 
 	"github.com/Masterminds/sprig"
 	"github.com/davecgh/go-spew/spew"
@@ -19,6 +16,7 @@ import (
 	"github.com/golang/protobuf/protoc-gen-go/descriptor"
 	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 	"github.com/jhump/protoreflect/desc"
+	"github.com/pkg/errors"
 	"github.com/tmc/grpcutil/protoc-gen-tstypes/opts"
 
 	"google.golang.org/genproto/googleapis/api/annotations"
@@ -38,7 +36,33 @@ type Parameters struct {
 	OriginalNames         bool
 	Verbose               int
 	Int64AsString         bool
-	// TODO: allow template specification?
+	Int64                 Int64Representation
+	WellKnownTypesAsJSON  bool
+	OneofsAsUnions        bool
+	EmitDefaults          bool
+	ESModules             bool
+	JSONCodecs            bool
+	HTTPClient            bool
+	GRPCWeb               bool
+	ServerHandlers        bool
+	CallOptions           bool
+	StatusDetails         bool
+	StatusDetailTypes     []string
+	AnyTypes              bool
+	NestedNamespaces      bool
+	JSONSchema            bool
+	Zod                   bool
+	ImportMap             map[string]string
+	Bundle                string
+	JSDoc                 bool
+	EnumStyle             EnumStyle
+	StripEnumPrefix       bool
+	OmitEnumZeros         bool
+	InputTypes            bool
+	Dependencies          bool
+	DependencyAllow       []string
+	DependencyDeny        []string
+	TemplateDir           string
 
 	MessageOptionsFunc MessageOptionsFunc
 	FieldOptionsFunc   FieldOptionsFunc
@@ -49,13 +73,33 @@ type Generator struct {
 	indent   string
 	Request  *plugin.CodeGeneratorRequest
 	Response *plugin.CodeGeneratorResponse
+
+	// files are the files in the output being generated and file the one
+	// being written, imports the types the output imports in module mode and
+	// helpers the codec helpers it uses.
+	files   map[*desc.FileDescriptor]bool
+	file    *desc.FileDescriptor
+	imports *importSet
+	helpers map[string]bool
+	// inputShape is set while the input shapes of messages are written.
+	inputShape bool
+	// templates are the user templates loaded from Parameters.TemplateDir.
+	templates *template.Template
+	// statusDetails are the known google.rpc.Status detail types.
+	statusDetails []*desc.MessageDescriptor
 }
 
+// OutputNameContext is the data the output name pattern is executed with.
 type OutputNameContext struct {
 	Dir        string
 	BaseName   string
 	Descriptor *desc.FileDescriptor
 	Request    *plugin.CodeGeneratorRequest
+	// GoPackage is the import path of the go_package option, without the
+	// package name that may follow a semicolon.
+	GoPackage string
+	Services  []*desc.ServiceDescriptor
+	Options   *descriptor.FileOptions
 }
 
 type MessageOptions struct {
@@ -64,13 +108,25 @@ type MessageOptions struct {
 
 type FieldOptions struct {
 	IsRequired bool
+	// Int64 overrides the representation of 64 bit integers if set.
+	Int64 Int64Representation
+	// OutputOnly, InputOnly and Immutable follow google.api.field_behavior,
+	// they select the shapes a field is part of with Parameters.InputTypes.
+	OutputOnly bool
+	InputOnly  bool
+	Immutable  bool
+	// AnyTypes restricts the union of an Any field with Parameters.AnyTypes
+	// to the messages with these fully qualified names.
+	AnyTypes []string
 }
 
 func New() *Generator {
 	return &Generator{
-		Buffer:   new(bytes.Buffer),
-		Request:  new(plugin.CodeGeneratorRequest),
-		Response: new(plugin.CodeGeneratorResponse),
+		Buffer:  new(bytes.Buffer),
+		Request: new(plugin.CodeGeneratorRequest),
+		Response: &plugin.CodeGeneratorResponse{
+			SupportedFeatures: proto.Uint64(uint64(plugin.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)),
+		},
 	}
 }
 
@@ -110,7 +166,7 @@ var s = &spew.ConfigState{
 	DisableCapacities:       true,
 }
 
-func genName(r *plugin.CodeGeneratorRequest, f *desc.FileDescriptor, outPattern string) string {
+func genName(r *plugin.CodeGeneratorRequest, f *desc.FileDescriptor, outPattern string) (string, error) {
 	// TODO: consider using go_package if present?
 
 	n := filepath.Base(f.GetName())
@@ -122,76 +178,319 @@ func genName(r *plugin.CodeGeneratorRequest, f *desc.FileDescriptor, outPattern
 		BaseName:   n,
 		Descriptor: f,
 		Request:    r,
+		GoPackage:  strings.SplitN(f.GetFileOptions().GetGoPackage(), ";", 2)[0],
+		Services:   f.GetServices(),
+		Options:    f.GetFileOptions(),
+	}
+	t, err := template.New("outpattern").Funcs(sprig.TxtFuncMap()).Funcs(outputNameFuncs).Parse(outPattern)
+	if err != nil {
+		return "", errors.Wrap(err, "parsing outpattern")
 	}
-	var t = template.Must(template.New("gentstypes/generator.go:genName").Funcs(sprig.FuncMap()).Parse(outPattern))
 	buf := new(bytes.Buffer)
 	if err := t.Execute(buf, ctx); err != nil {
-		log.Fatalln("issue rendering template:", err)
+		return "", errors.Wrap(err, "rendering outpattern")
+	}
+	return buf.String(), nil
+}
+
+// outputNameFuncs are the functions of the output name pattern, in addition to
+// the sprig functions.
+var outputNameFuncs = template.FuncMap{
+	"slug": slug,
+}
+
+// slug returns s in lower case with words separated by hyphens, e.g.
+// route-guide for RouteGuide or route_guide and http-server for HTTPServer.
+// Characters other than letters and digits separate words.
+func slug(s string) string {
+	b := strings.Builder{}
+	hyphen := false
+	rs := []rune(s)
+	for i, r := range rs {
+		switch {
+		case unicode.IsUpper(r):
+			// An upper case letter starts a word after a lower case letter
+			// or a digit, and after an acronym if a lower case letter
+			// follows it.
+			if i > 0 && (isLowerOrDigit(rs[i-1]) || unicode.IsUpper(rs[i-1]) && i+1 < len(rs) && unicode.IsLower(rs[i+1])) {
+				hyphen = true
+			}
+			r = unicode.ToLower(r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+		default:
+			hyphen = true
+			continue
+		}
+		if hyphen && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		hyphen = false
+		b.WriteRune(r)
 	}
-	return buf.String()
+	return b.String()
+}
+
+func isLowerOrDigit(r rune) bool {
+	return unicode.IsLower(r) || unicode.IsDigit(r)
 }
 
-func (g *Generator) GenerateAllFiles(params *Parameters) {
-	g.W("// Code generated by protoc-gen-tstypes. DO NOT EDIT.\n")
+// GenerateAllFiles generates the files requested by g.Request and adds them
+// to g.Response. Errors name the proto file and element they occurred in.
+func (g *Generator) GenerateAllFiles(params *Parameters) error {
+	if params.JSONCodecs && !(params.ESModules && params.WellKnownTypesAsJSON) {
+		// The codecs convert the canonical JSON of the well-known types.
+		return errors.New("json_codecs requires es_modules and wkt_json")
+	}
+	if params.HTTPClient && !params.JSONCodecs {
+		// The requests are sent as JSON, the codecs encode their bytes and
+		// well-known types.
+		return errors.New("http_client requires json_codecs")
+	}
+	if params.Int64 == Int64BigInt && !params.JSONCodecs {
+		return errors.New("int64=bigint requires json_codecs")
+	}
 	files, err := desc.CreateFileDescriptors(g.Request.ProtoFile)
 	if params.DumpRequestDescriptor {
 		s.Fdump(os.Stderr, g.Request)
 	}
 	if err != nil {
-		log.Fatal(err)
+		return errors.Wrap(err, "loading descriptors")
 	}
 	names := []string{}
 	for _, fname := range g.Request.FileToGenerate {
 		names = append(names, fname)
 	}
 	sort.Strings(names)
+	generated := []*desc.FileDescriptor{}
 	for _, n := range names {
-		f := files[n]
-		g.generate(f, params)
+		f, ok := files[n]
+		if !ok {
+			return errors.Errorf("%s: file to generate is missing from the request", n)
+		}
+		if m, ok := params.ImportMap[n]; ok {
+			if params.Verbose > 0 {
+				fmt.Fprintln(os.Stderr, "skipping", n, "imported from", m)
+			}
+			continue
+		}
+		generated = append(generated, f)
+	}
+	if params.StatusDetails && hasServices(generated) {
+		if g.statusDetails, err = statusDetailTypes(files, params); err != nil {
+			return err
+		}
+		generated = append(generated, statusDetailFiles(generated, g.statusDetails, params)...)
+	}
+	if params.Dependencies {
+		generated = append(generated, dependencyFiles(generated, params)...)
+	}
+	if params.TemplateDir != "" {
+		if g.templates, err = loadTemplates(params.TemplateDir); err != nil {
+			return err
+		}
+	}
+	if params.Bundle != "" {
+		return g.generateOutput(params.Bundle, generated, params)
+	}
+	for _, f := range generated {
+		if err := g.generate(f, params); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
-func (g *Generator) generate(f *desc.FileDescriptor, params *Parameters) {
-	// TODO: consider best order
-	ns := params.DeclareNamespace && f.GetPackage() != ""
-	if ns {
-		g.W(fmt.Sprintf("declare namespace %s {\n", f.GetPackage()))
-		g.incIndent()
+// generate generates the output file of f, named by the output name pattern.
+func (g *Generator) generate(f *desc.FileDescriptor, params *Parameters) error {
+	n, err := genName(g.Request, f, params.OutputNamePattern)
+	if err != nil {
+		return errors.Wrap(err, f.GetName())
 	}
+	return g.generateOutput(n, []*desc.FileDescriptor{f}, params)
+}
 
-	g.generateEnums(f.GetEnumTypes(), params)
-	g.generateMessages(f.GetMessageTypes(), params)
-	g.generateServices(f.GetServices(), params)
+// generateOutput generates the output file name holding the types and
+// services of files. Each package is declared in one namespace, merging the
+// files that share it, types without a package are declared at the top level.
+func (g *Generator) generateOutput(name string, files []*desc.FileDescriptor, params *Parameters) error {
+	g.files = map[*desc.FileDescriptor]bool{}
+	for _, f := range files {
+		g.files[f] = true
+	}
+	g.imports = newImportSet(files)
+	g.helpers = map[string]bool{}
+	if params.ESModules && params.Bundle != "" {
+		// Imports must not be shadowed by the package namespaces.
+		for _, f := range files {
+			if p := f.GetPackage(); p != "" {
+				g.imports.reserve(strings.SplitN(p, ".", 2)[0])
+			}
+		}
+	}
+	if params.JSONCodecs {
+		g.reserveCodecNames(files)
+	}
+	if params.HTTPClient {
+		g.reserveHTTPClientNames(files)
+	}
+	if params.GRPCWeb {
+		g.reserveGRPCWebNames(files)
+	}
+	if params.ServerHandlers {
+		g.reserveServerNames(files)
+	}
+	if params.HTTPClient || params.GRPCWeb || params.CallOptions {
+		g.reserveCallNames()
+	}
+	if params.Zod {
+		g.reserveZodNames(files)
+	}
+	if params.StatusDetails {
+		g.reserveStatusDetailNames()
+	}
+	if params.AnyTypes {
+		g.reserveAnyNames()
+	}
+	g.reserveInputNames(files, params)
+	header := &HeaderData{Name: name, Files: files, Params: params}
+	if ok, err := g.execTemplate("header.tmpl", header); err != nil {
+		return errors.Wrap(err, name)
+	} else if !ok {
+		g.W("// Code generated by protoc-gen-tstypes. DO NOT EDIT.\n")
+	}
+	bodyStart := g.Len()
 
-	if ns {
-		g.decIndent()
-		g.W("}\n")
+	for _, pkg := range packageFiles(files) {
+		if !params.NestedNamespaces {
+			if err := checkNameCollisions(pkg); err != nil {
+				return err
+			}
+		}
+		if err := checkInputNameCollisions(pkg, params); err != nil {
+			return err
+		}
+		if err := g.checkAnyTypes(pkg, params); err != nil {
+			return err
+		}
+		// TODO: consider best order
+		ns := ""
+		if p := pkg[0].GetPackage(); params.DeclareNamespace && p != "" {
+			if !params.ESModules {
+				ns = "declare namespace " + p
+			} else if params.Bundle != "" {
+				ns = "export namespace " + p
+			}
+		}
+		if ns != "" {
+			g.W(ns + " {\n")
+			g.incIndent()
+		}
+		for i, f := range pkg {
+			if i > 0 && !strings.HasSuffix(g.String(), "\n\n") {
+				g.Buffer.WriteString("\n")
+			}
+			g.file = f
+			if err := g.generateEnums(f.GetEnumTypes(), params); err != nil {
+				return errors.Wrap(err, f.GetName())
+			}
+			if err := g.generateMessages(f.GetMessageTypes(), params); err != nil {
+				return errors.Wrap(err, f.GetName())
+			}
+			if err := g.generateServices(f.GetServices(), params); err != nil {
+				return errors.Wrap(err, f.GetName())
+			}
+		}
+		if ns != "" {
+			g.decIndent()
+			g.W("}\n")
+		}
+	}
+	if params.AnyTypes {
+		g.generateTypeRegistry(files, params)
+	}
+	if params.StatusDetails && hasServices(files) {
+		g.generateStatusDetails(params)
+	}
+	g.generateHelpers()
+	if params.AnyTypes && (params.JSONCodecs || params.Zod) {
+		g.generateRegistration(files, params)
 	}
-	n := genName(g.Request, f, params.OutputNamePattern)
+
 	if params.Verbose > 0 {
-		fmt.Fprintln(os.Stderr, "generating", n)
+		fmt.Fprintln(os.Stderr, "generating", name)
+	}
+	content := g.String()
+	imports, err := g.imports.importStatements(func(dep *desc.FileDescriptor) (string, error) {
+		if m, ok := params.ImportMap[dep.GetName()]; ok {
+			return m, nil
+		}
+		depName, err := genName(g.Request, dep, params.OutputNamePattern)
+		if err != nil {
+			return "", errors.Wrap(err, dep.GetName())
+		}
+		return relativeModulePath(name, depName), nil
+	})
+	if err != nil {
+		return errors.Wrap(err, name)
+	}
+	if len(imports) > 0 {
+		content = content[:bodyStart] + strings.Join(imports, "\n") + "\n\n" + content[bodyStart:]
 	}
 	g.Response.File = append(g.Response.File, &plugin.CodeGeneratorResponse_File{
-		Name:    proto.String(n),
-		Content: proto.String(g.String()),
+		Name:    proto.String(name),
+		Content: proto.String(content),
 	})
 	g.Buffer.Reset()
+	if params.JSONSchema {
+		schema, err := g.generateJSONSchema(files, name, params)
+		if err != nil {
+			return errors.Wrap(err, name)
+		}
+		g.Response.File = append(g.Response.File, schema)
+	}
+	return nil
+}
+
+// packageFiles groups files by package, in the order the packages first
+// appear in.
+func packageFiles(files []*desc.FileDescriptor) [][]*desc.FileDescriptor {
+	result := [][]*desc.FileDescriptor{}
+	index := map[string]int{}
+	for _, f := range files {
+		i, ok := index[f.GetPackage()]
+		if !ok {
+			i = len(result)
+			index[f.GetPackage()] = i
+			result = append(result, nil)
+		}
+		result[i] = append(result[i], f)
+	}
+	return result
 }
 
-func (g *Generator) generateMessages(messages []*desc.MessageDescriptor, params *Parameters) {
+func (g *Generator) generateMessages(messages []*desc.MessageDescriptor, params *Parameters) error {
 	for _, m := range messages {
-		g.generateMessage(m, params)
+		if err := g.generateMessage(m, params); err != nil {
+			return err
+		}
 	}
+	return nil
 }
-func (g *Generator) generateEnums(enums []*desc.EnumDescriptor, params *Parameters) {
+func (g *Generator) generateEnums(enums []*desc.EnumDescriptor, params *Parameters) error {
 	for _, e := range enums {
-		g.generateEnum(e, params)
+		if err := g.generateEnum(e, params); err != nil {
+			return err
+		}
 	}
+	return nil
 }
-func (g *Generator) generateServices(services []*desc.ServiceDescriptor, params *Parameters) {
+func (g *Generator) generateServices(services []*desc.ServiceDescriptor, params *Parameters) error {
 	for _, e := range services {
-		g.generateService(e, params)
+		if err := g.generateService(e, params); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 func DefaultMessageOptionsFunc(m *desc.MessageDescriptor) MessageOptions {
@@ -199,85 +498,281 @@ func DefaultMessageOptionsFunc(m *desc.MessageDescriptor) MessageOptions {
 	if o, err := proto.GetExtension(m.AsDescriptorProto().Options, opts.E_FieldDefaults); err == nil {
 		if o, ok := o.(*opts.Options); ok {
 			fieldRequiredDefault := o.GetRequired() || o.GetFieldBehavior() == annotations.FieldBehavior_REQUIRED
-			result.DefaultFieldOptions = &FieldOptions{IsRequired: fieldRequiredDefault}
+			result.DefaultFieldOptions = &FieldOptions{IsRequired: fieldRequiredDefault, Int64: int64Representations[o.GetInt64()]}
+			setFieldBehavior(result.DefaultFieldOptions, o.GetFieldBehavior())
 		}
 	}
 	return result
 }
 
 func DefaultFieldOptionsFunc(mOpts MessageOptions, f *desc.FieldDescriptor) FieldOptions {
-	required := false
+	result := FieldOptions{}
 	if mOpts.DefaultFieldOptions != nil {
-		required = mOpts.DefaultFieldOptions.IsRequired
+		result = *mOpts.DefaultFieldOptions
 	}
 	e, err := proto.GetExtension(f.AsFieldDescriptorProto().Options, opts.E_Field)
 	if err == nil {
 		if e, ok := e.(*opts.Options); ok {
-			required = e.GetRequired()
+			// Options set on the field override the message defaults.
+			if e.Required != nil {
+				result.IsRequired = e.GetRequired()
+			}
+			if r, ok := int64Representations[e.GetInt64()]; ok {
+				result.Int64 = r
+			}
+			result.AnyTypes = e.GetAnyTypes()
 		}
 	}
 	if o, err := proto.GetExtension(f.AsFieldDescriptorProto().Options, annotations.E_FieldBehavior); err == nil {
 		if opts, ok := o.([]annotations.FieldBehavior); ok {
 			for _, opt := range opts {
-				if opt == annotations.FieldBehavior_REQUIRED {
-					required = true
-				}
+				setFieldBehavior(&result, opt)
 			}
 		}
 	}
-	return FieldOptions{IsRequired: required}
+	return result
+}
+
+// setFieldBehavior records the field behavior b in fOpts.
+func setFieldBehavior(fOpts *FieldOptions, b annotations.FieldBehavior) {
+	switch b {
+	case annotations.FieldBehavior_REQUIRED:
+		fOpts.IsRequired = true
+	case annotations.FieldBehavior_OUTPUT_ONLY:
+		fOpts.OutputOnly = true
+	case annotations.FieldBehavior_INPUT_ONLY:
+		fOpts.InputOnly = true
+	case annotations.FieldBehavior_IMMUTABLE:
+		fOpts.Immutable = true
+	}
+}
+
+// fieldOptions returns the options of f, derived by the option functions of
+// params.
+func fieldOptions(f *desc.FieldDescriptor, params *Parameters) FieldOptions {
+	mOptsFn := DefaultMessageOptionsFunc
+	if params.MessageOptionsFunc != nil {
+		mOptsFn = params.MessageOptionsFunc
+	}
+	fOptsFn := DefaultFieldOptionsFunc
+	if params.FieldOptionsFunc != nil {
+		fOptsFn = params.FieldOptionsFunc
+	}
+	return fOptsFn(mOptsFn(f.GetOwner()), f)
+}
+
+func (g *Generator) generateMessage(m *desc.MessageDescriptor, params *Parameters) error {
+	if params.NestedNamespaces {
+		if err := g.generateMessageType(m, params); err != nil {
+			return err
+		}
+		if params.JSONCodecs {
+			g.generateMessageCodecs(m, params)
+		}
+		if params.Zod {
+			g.generateMessageSchema(m, params)
+		}
+		if len(m.GetNestedEnumTypes()) == 0 && len(m.GetNestedMessageTypes()) == 0 {
+			return nil
+		}
+		// The namespace merges with the declaration of m.
+		g.W(fmt.Sprintf("export namespace %s {", m.GetName()))
+		g.incIndent()
+		if err := g.generateEnums(m.GetNestedEnumTypes(), params); err != nil {
+			return err
+		}
+		if err := g.generateMessages(m.GetNestedMessageTypes(), params); err != nil {
+			return err
+		}
+		g.decIndent()
+		if strings.HasSuffix(g.String(), "\n\n") {
+			g.Truncate(g.Len() - 1)
+		}
+		g.W("}\n")
+		return nil
+	}
+	if err := g.generateEnums(m.GetNestedEnumTypes(), params); err != nil {
+		return err
+	}
+	if err := g.generateMessages(m.GetNestedMessageTypes(), params); err != nil {
+		return err
+	}
+	if err := g.generateMessageType(m, params); err != nil {
+		return err
+	}
+	if params.JSONCodecs {
+		g.generateMessageCodecs(m, params)
+	}
+	if params.Zod {
+		g.generateMessageSchema(m, params)
+	}
+	return nil
 }
 
-func (g *Generator) generateMessage(m *desc.MessageDescriptor, params *Parameters) {
-	// TODO: namespace messages?
-	for _, e := range m.GetNestedEnumTypes() {
-		g.generateEnum(e, params)
+// generateMessageType declares the type of m and, if it differs, the type of
+// its input shape.
+func (g *Generator) generateMessageType(m *desc.MessageDescriptor, params *Parameters) error {
+	var data *MessageData
+	if g.hasTemplate("message.tmpl") {
+		// Describing the fields imports their types.
+		data = g.messageData(m, params)
+	}
+	if ok, err := g.execTemplate("message.tmpl", data); err != nil {
+		return errors.Wrap(err, m.GetFullyQualifiedName())
+	} else if ok {
+		return nil
 	}
-	for _, m := range m.GetNestedMessageTypes() {
-		g.generateMessage(m, params)
+	name := declName(m, params)
+	g.generateMessageShape(m, name, params)
+	if needsInput(m, params) {
+		g.inputShape = true
+		g.generateMessageShape(m, name+inputSuffix, params)
+		g.inputShape = false
 	}
-	name := packageQualifiedName(m)
+	return nil
+}
 
+// generateMessageShape declares the type name of m in the shape being
+// generated.
+func (g *Generator) generateMessageShape(m *desc.MessageDescriptor, name string, params *Parameters) {
 	mOpts := DefaultMessageOptionsFunc(m)
 	if params.MessageOptionsFunc != nil {
 		mOpts = params.MessageOptionsFunc(m)
 	}
 
-	g.wcomment(m.GetSourceInfo().GetLeadingComments())
-	g.W(fmt.Sprintf("export interface %s {", name))
-	for _, f := range m.GetFields() {
-		name := f.GetName()
-		if !params.OriginalNames {
-			name = f.GetJSONName()
+	fOptsFn := DefaultFieldOptionsFunc
+	if params.FieldOptionsFunc != nil {
+		fOptsFn = params.FieldOptionsFunc
+	}
+
+	g.wdoc(m, params)
+	oneOfs := []*desc.OneOfDescriptor{}
+	if params.OneofsAsUnions {
+		for _, o := range m.GetOneOfs() {
+			// proto3 optional fields are wrapped in synthetic oneofs.
+			if !o.IsSynthetic() && len(g.inShape(o.GetChoices(), params)) > 0 {
+				oneOfs = append(oneOfs, o)
+			}
 		}
-		fOptsFn := DefaultFieldOptionsFunc
-		if params.FieldOptionsFunc != nil {
-			fOptsFn = params.FieldOptionsFunc
+	}
+	if len(oneOfs) == 0 {
+		g.W(fmt.Sprintf("export interface %s {", name))
+		for _, f := range g.shapeFields(m, params) {
+			g.generateField(f, isRequired(f, fOptsFn(mOpts, f), params), params)
 		}
-		fOpts := fOptsFn(mOpts, f)
-		required := fOpts.IsRequired
+		g.W("}\n")
+		return
+	}
 
-		suffix := ""
-		if !required {
-			suffix = "?"
+	// Each oneof becomes a union of object types in which exactly one member
+	// (or none of them) is present, intersected with the regular fields.
+	regular := []*desc.FieldDescriptor{}
+	for _, f := range g.shapeFields(m, params) {
+		if o := f.GetOneOf(); o == nil || o.IsSynthetic() {
+			regular = append(regular, f)
+		}
+	}
+	// The documentation of a oneof is written before the union it becomes.
+	if len(regular) == 0 {
+		if hasDoc(oneOfs[0], params) {
+			g.W(fmt.Sprintf("export type %s =", name))
+			g.wdoc(oneOfs[0], params)
+			g.W("({")
+		} else {
+			g.W(fmt.Sprintf("export type %s = ({", name))
+		}
+	} else {
+		g.W(fmt.Sprintf("export type %s = {", name))
+		for _, f := range regular {
+			g.generateField(f, isRequired(f, fOptsFn(mOpts, f), params), params)
+		}
+		g.wdoc(oneOfs[0], params)
+		g.W("} & ({")
+	}
+	for i, o := range oneOfs {
+		if i > 0 {
+			g.wdoc(o, params)
+			g.W("}) & ({")
 		}
+		for j, f := range g.inShape(o.GetChoices(), params) {
+			if j > 0 {
+				g.W("} | {")
+			}
+			g.generateOneOfChoice(o, f, params)
+		}
+		g.W("} | {")
+		g.generateOneOfChoice(o, nil, params)
+	}
+	g.W("});\n")
+}
 
-		g.incIndent()
-		g.wcomment(f.GetSourceInfo().GetLeadingComments())
-		g.decIndent()
-		trailingComment := ""
-		if comment := f.GetSourceInfo().GetTrailingComments(); comment != "" {
-			trailingComment = " // " + strings.TrimSpace(comment)
+// isRequired reports whether f is emitted as a non-optional property.
+func isRequired(f *desc.FieldDescriptor, fOpts FieldOptions, params *Parameters) bool {
+	if fOpts.IsRequired || f.IsRequired() {
+		return true
+	}
+	// Fields without presence are always written by a marshaler that emits
+	// default values, everything else may still be absent.
+	return params.EmitDefaults && !hasPresence(f)
+}
+
+// hasPresence reports whether f has explicit presence, that is whether an
+// unset value can be distinguished from the default value. This is the case
+// for proto3 optional fields, proto2 optional fields, message fields and
+// oneof members.
+func hasPresence(f *desc.FieldDescriptor) bool {
+	return !f.IsRepeated() && f.HasPresence()
+}
+
+// generateOneOfChoice writes the members of o for the union variant in which
+// only the field selected is set. If selected is nil no member is set.
+func (g *Generator) generateOneOfChoice(o *desc.OneOfDescriptor, selected *desc.FieldDescriptor, params *Parameters) {
+	for _, f := range g.inShape(o.GetChoices(), params) {
+		if f == selected {
+			g.generateField(f, true, params)
+			continue
 		}
-		g.W(fmt.Sprintf(indent+"%s%s: %s;%s", name, suffix, fieldType(f, params), trailingComment))
+		g.W(fmt.Sprintf(indent+"%s?: never;", fieldName(f, params)))
 	}
-	g.W("}\n")
 }
 
-func fieldType(f *desc.FieldDescriptor, params *Parameters) string {
-	t := rawFieldType(f, params)
+func (g *Generator) generateField(f *desc.FieldDescriptor, required bool, params *Parameters) {
+	suffix := ""
+	if !required {
+		suffix = "?"
+	}
+
+	g.incIndent()
+	g.wdoc(f, params)
+	g.decIndent()
+	trailingComment := ""
+	if comment := f.GetSourceInfo().GetTrailingComments(); comment != "" && !params.JSDoc {
+		trailingComment = " // " + strings.TrimSpace(comment)
+	}
+	readonly := ""
+	if g.isReadOnly(f, params) {
+		readonly = "readonly "
+	}
+	g.W(fmt.Sprintf(indent+"%s%s%s: %s;%s", readonly, fieldName(f, params), suffix, g.fieldType(f, params), trailingComment))
+}
+
+func fieldName(f *desc.FieldDescriptor, params *Parameters) string {
+	if !params.OriginalNames {
+		return f.GetJSONName()
+	}
+	return f.GetName()
+}
+
+func (g *Generator) fieldType(f *desc.FieldDescriptor, params *Parameters) string {
+	t := g.rawFieldType(f, params)
 	if f.IsMap() {
-		return fmt.Sprintf("{ [key: %s]: %s }", rawFieldType(f.GetMapKeyType(), params), rawFieldType(f.GetMapValueType(), params))
+		k := f.GetMapKeyType()
+		key := g.rawFieldType(k, params)
+		if isInt64(k) && int64Representation(k, params) == Int64BigInt {
+			// Index signatures do not allow bigint keys.
+			key = "string"
+		}
+		return fmt.Sprintf("{ [key: %s]: %s }", key, g.rawFieldType(f.GetMapValueType(), params))
 	}
 	if f.IsRepeated() {
 		return fmt.Sprintf("Array<%s>", t)
@@ -285,7 +780,7 @@ func fieldType(f *desc.FieldDescriptor, params *Parameters) string {
 	return t
 }
 
-func rawFieldType(f *desc.FieldDescriptor, params *Parameters) string {
+func (g *Generator) rawFieldType(f *desc.FieldDescriptor, params *Parameters) string {
 	switch f.GetType() {
 	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
 		fallthrough
@@ -310,11 +805,7 @@ func rawFieldType(f *desc.FieldDescriptor, params *Parameters) string {
 	case descriptor.FieldDescriptorProto_TYPE_SFIXED64:
 		fallthrough
 	case descriptor.FieldDescriptorProto_TYPE_SINT64:
-		if params.Int64AsString {
-			return "string"
-		} else {
-			return "number"
-		}
+		return int64Representation(f, params).tsType(g.inputShape)
 	case descriptor.FieldDescriptorProto_TYPE_BOOL:
 		return "boolean"
 	case descriptor.FieldDescriptorProto_TYPE_STRING:
@@ -322,21 +813,112 @@ func rawFieldType(f *desc.FieldDescriptor, params *Parameters) string {
 	case descriptor.FieldDescriptorProto_TYPE_BYTES:
 		return "Uint8Array"
 	case descriptor.FieldDescriptorProto_TYPE_ENUM:
-		t := f.GetEnumType()
-		if t.GetFile().GetPackage() != f.GetFile().GetPackage() {
-			return t.GetFullyQualifiedName()
-		}
-		return packageQualifiedName(t)
+		return g.typeName(f.GetEnumType(), params)
 	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
 		t := f.GetMessageType()
-		if t.GetFile().GetPackage() != f.GetFile().GetPackage() {
-			return t.GetFullyQualifiedName()
+		if params.WellKnownTypesAsJSON {
+			if isWrapperType(t) && isInt64(t.FindFieldByName("value")) {
+				// The options of f apply to the wrapped value.
+				return int64Representation(f, params).tsType(g.inputShape) + " | null"
+			}
+			if params.AnyTypes && isAny(f) {
+				return g.anyType(f, params)
+			}
+			if wkt := g.wellKnownType(t, params); wkt != "" {
+				return wkt
+			}
+		}
+		if g.inputShape {
+			return g.inputTypeName(t, params)
 		}
-		return packageQualifiedName(t)
+		return g.typeName(t, params)
 	}
 	return "any /*unknown*/"
 }
 
+// typeName returns the name the message or enum d is referred to by in the
+// file being generated. In module mode types declared in other outputs are
+// imported, otherwise types from other packages are qualified with their
+// package name.
+func (g *Generator) typeName(d desc.Descriptor, params *Parameters) string {
+	if params.ESModules && !g.files[d.GetFile()] {
+		return g.importName(d, "", false, params)
+	}
+	return g.localName(d, "", params)
+}
+
+// localName returns the name of the message or enum d, or of its codec
+// function if suffix is set, qualified with the package of d if it differs
+// from the package of the file being generated.
+func (g *Generator) localName(d desc.Descriptor, suffix string, params *Parameters) string {
+	if pkg := d.GetFile().GetPackage(); pkg != g.file.GetPackage() && pkg != "" {
+		return pkg + "." + qualifiedName(d, params) + suffix
+	}
+	return qualifiedName(d, params) + suffix
+}
+
+// importName imports the message or enum d, or its codec function if suffix
+// is set, from the file generated for the file d is declared in and returns
+// the name it is referred to by. In nested namespace mode the outermost
+// message enclosing d is imported instead.
+func (g *Generator) importName(d desc.Descriptor, suffix string, value bool, params *Parameters) string {
+	if !params.NestedNamespaces {
+		return g.imports.addName(d.GetFile(), packageQualifiedName(d)+suffix, value)
+	}
+	top := d
+	for {
+		if _, ok := top.GetParent().(*desc.FileDescriptor); ok {
+			break
+		}
+		top = top.GetParent()
+	}
+	if top == d {
+		return g.imports.addName(d.GetFile(), d.GetName()+suffix, value)
+	}
+	local := g.imports.addName(d.GetFile(), top.GetName(), value)
+	return local + strings.TrimPrefix(qualifiedName(d, params), top.GetName()) + suffix
+}
+
+// declName returns the name the message or enum d is declared with. In nested
+// namespace mode nested types are declared in the namespace of their parent.
+func declName(d desc.Descriptor, params *Parameters) string {
+	if params.NestedNamespaces {
+		return d.GetName()
+	}
+	return packageQualifiedName(d)
+}
+
+// qualifiedName returns the name of the message or enum d relative to its
+// package. In nested namespace mode the names of the enclosing messages are
+// joined with dots, otherwise with underscores.
+func qualifiedName(d desc.Descriptor, params *Parameters) string {
+	if !params.NestedNamespaces {
+		return packageQualifiedName(d)
+	}
+	name := d.GetName()
+	for c := d.GetParent(); c.GetParent() != nil; c = c.GetParent() {
+		name = c.GetName() + "." + name
+	}
+	return name
+}
+
+// checkNameCollisions returns an error if several types of files, which
+// share a package, have the same flattened name, such as a message A_B and
+// the message B nested in A.
+func checkNameCollisions(files []*desc.FileDescriptor) error {
+	seen := map[string]desc.Descriptor{}
+	for _, f := range files {
+		for _, d := range fileTypes(f) {
+			name := packageQualifiedName(d)
+			if other, ok := seen[name]; ok {
+				return errors.Errorf("%s: %s and %s are both generated as %s, use nested_namespaces to avoid the collision", f.GetName(), other.GetFullyQualifiedName(), d.GetFullyQualifiedName(), name)
+			}
+			seen[name] = d
+		}
+	}
+	return nil
+}
+
 func packageQualifiedName(e desc.Descriptor) string {
 	name := e.GetName()
 	var c desc.Descriptor
@@ -346,25 +928,119 @@ func packageQualifiedName(e desc.Descriptor) string {
 	return name
 }
 
-func (g *Generator) generateEnum(e *desc.EnumDescriptor, params *Parameters) {
-	name := packageQualifiedName(e)
-	g.W(fmt.Sprintf("export enum %s {", name))
-	for _, v := range e.GetValues() {
-		if params.EnumsAsInt {
-			g.W(fmt.Sprintf("    %s = %v,", v.GetName(), v.GetNumber()))
+func (g *Generator) generateEnum(e *desc.EnumDescriptor, params *Parameters) error {
+	name := declName(e, params)
+	if ok, err := g.execTemplate("enum.tmpl", enumData(e, params)); err != nil {
+		return errors.Wrap(err, e.GetFullyQualifiedName())
+	} else if ok {
+		g.generateEnumRuntime(e, params)
+		return nil
+	}
+	if params.JSDoc {
+		g.wdoc(e, params)
+	}
+	values := enumValues(e, params)
+	switch params.EnumStyle {
+	case EnumStyleUnion:
+		literals := []string{}
+		for _, v := range values {
+			literals = append(literals, enumValueLiteral(v, params))
+		}
+		if len(literals) == 0 {
+			literals = append(literals, "never")
+		}
+		g.W(fmt.Sprintf("export type %s = %s;", name, strings.Join(literals, " | ")))
+	case EnumStyleConstObject:
+		// Declaration files cannot hold the object itself.
+		if params.ESModules {
+			g.W(fmt.Sprintf("export const %s = {", name))
 		} else {
-			g.W(fmt.Sprintf("    %s = \"%v\",", v.GetName(), v.GetName()))
+			g.W(fmt.Sprintf("export const %s: {", name))
+		}
+		for _, v := range values {
+			if params.JSDoc {
+				g.incIndent()
+				g.wdoc(v, params)
+				g.decIndent()
+			}
+			if params.ESModules {
+				g.W(fmt.Sprintf(indent+"%s: %s,", enumMemberName(v, params), enumValueLiteral(v, params)))
+			} else {
+				g.W(fmt.Sprintf(indent+"readonly %s: %s;", enumMemberName(v, params), enumValueLiteral(v, params)))
+			}
 		}
+		if params.ESModules {
+			g.W("} as const;")
+		} else {
+			g.W("};")
+		}
+		g.W(fmt.Sprintf("export type %s = typeof %s[keyof typeof %s];", name, name, name))
+	default:
+		keyword := "enum"
+		if params.EnumStyle == EnumStyleConstEnum {
+			keyword = "const enum"
+		}
+		g.W(fmt.Sprintf("export %s %s {", keyword, name))
+		for _, v := range values {
+			if params.JSDoc {
+				g.incIndent()
+				g.wdoc(v, params)
+				g.decIndent()
+			}
+			g.W(fmt.Sprintf(indent+"%s = %s,", enumMemberName(v, params), enumValueLiteral(v, params)))
+		}
+		g.W("}")
 	}
-	g.W("}")
+	g.generateEnumRuntime(e, params)
+	return nil
 }
 
-func (g *Generator) generateService(service *desc.ServiceDescriptor, params *Parameters) {
-	g.W(fmt.Sprintf("export interface %sService {", service.GetName()))
-	g.incIndent()
-	g.generateServiceMethods(service, params)
-	g.decIndent()
-	g.W(fmt.Sprintf("}"))
+// generateEnumRuntime writes the codecs and schema of e following its
+// declaration.
+func (g *Generator) generateEnumRuntime(e *desc.EnumDescriptor, params *Parameters) {
+	if params.JSONCodecs {
+		if !strings.HasSuffix(g.String(), "\n\n") {
+			g.W("")
+		}
+		g.generateEnumCodecs(e, params)
+	}
+	if params.Zod {
+		if !strings.HasSuffix(g.String(), "\n\n") {
+			g.W("")
+		}
+		g.generateEnumSchema(e, params)
+	}
+}
+
+func (g *Generator) generateService(service *desc.ServiceDescriptor, params *Parameters) error {
+	var data *ServiceData
+	if g.hasTemplate("service.tmpl") {
+		data = g.serviceData(service, params)
+	}
+	if ok, err := g.execTemplate("service.tmpl", data); err != nil {
+		return errors.Wrap(err, service.GetFullyQualifiedName())
+	} else if !ok {
+		if params.JSDoc {
+			g.wdoc(service, params)
+		}
+		g.W(fmt.Sprintf("export interface %sService {", service.GetName()))
+		g.incIndent()
+		g.generateServiceMethods(service, params)
+		g.decIndent()
+		g.W(fmt.Sprintf("}"))
+	}
+	if params.HTTPClient && hasHTTPBindings(service) {
+		if err := g.generateHTTPClient(service, params); err != nil {
+			return err
+		}
+	}
+	if params.GRPCWeb && hasGRPCWebMethods(service) {
+		g.generateGRPCWebClient(service, params)
+	}
+	if params.ServerHandlers {
+		g.generateServerHandlers(service, params)
+	}
+	return nil
 }
 
 func (g *Generator) generateServiceMethods(service *desc.ServiceDescriptor, params *Parameters) {
@@ -373,8 +1049,20 @@ func (g *Generator) generateServiceMethods(service *desc.ServiceDescriptor, para
 	}
 }
 func (g *Generator) generateServiceMethod(method *desc.MethodDescriptor, params *Parameters) {
-	i := method.GetInputType().GetName()
-	o := method.GetOutputType().GetName()
+	if params.JSDoc {
+		g.wdoc(method, params)
+	}
+	g.W(g.serviceMethodSignature(method, params))
+}
+
+// serviceMethodSignature returns the member of the service interface
+// declaring method.
+func (g *Generator) serviceMethodSignature(method *desc.MethodDescriptor, params *Parameters) string {
+	if params.CallOptions {
+		return g.callSignature(method, params)
+	}
+	i := g.inputTypeName(method.GetInputType(), params)
+	o := g.typeName(method.GetOutputType(), params)
 	if params.AsyncIterators {
 		if method.IsServerStreaming() {
 			o = fmt.Sprintf("AsyncIterator<%s>", o)
@@ -382,21 +1070,17 @@ func (g *Generator) generateServiceMethod(method *desc.MethodDescriptor, params
 		if method.IsClientStreaming() {
 			i = fmt.Sprintf("AsyncIterator<%s>", i)
 		}
-		g.W(fmt.Sprintf("%s: (r:%s) => %s;", method.GetName(), i, o))
-	} else {
-		ss, cs := method.IsServerStreaming(), method.IsClientStreaming()
-		if !(ss || cs) {
-			g.W(fmt.Sprintf("%s: (r:%s) => %s;", method.GetName(), i, o))
-			return
-		}
-		if !cs {
-			g.W(fmt.Sprintf("%s: (r:%s, cb:(a:{value: %s, done: boolean}) => void) => void;", method.GetName(), i, o))
-			return
-		}
-		if !ss {
-			g.W(fmt.Sprintf("%s: (r:() => {value: %s, done: boolean}) => %s;", method.GetName(), i, o))
-			return
-		}
-		g.W(fmt.Sprintf("%s: (r:() => {value: %s, done: boolean}, cb:(a:{value: %s, done: boolean}) => void) => void;", method.GetName(), i, o))
+		return fmt.Sprintf("%s: (r:%s) => %s;", method.GetName(), i, o)
+	}
+	ss, cs := method.IsServerStreaming(), method.IsClientStreaming()
+	if !(ss || cs) {
+		return fmt.Sprintf("%s: (r:%s) => %s;", method.GetName(), i, o)
+	}
+	if !cs {
+		return fmt.Sprintf("%s: (r:%s, cb:(a:{value: %s, done: boolean}) => void) => void;", method.GetName(), i, o)
+	}
+	if !ss {
+		return fmt.Sprintf("%s: (r:() => {value: %s, done: boolean}) => %s;", method.GetName(), i, o)
 	}
+	return fmt.Sprintf("%s: (r:() => {value: %s, done: boolean}, cb:(a:{value: %s, done: boolean}) => void) => void;", method.GetName(), i, o)
 }
@@ -0,0 +1,248 @@
+package gentstypes
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+)
+
+// parseFiles parses the proto files of sources, keyed by name. Other imports
+// are read from the repository, as opts/opts.proto, or resolved from the
+// descriptors linked into the test.
+func parseFiles(t *testing.T, sources map[string]string) []*desc.FileDescriptor {
+	t.Helper()
+	p := protoparse.Parser{
+		Accessor: func(name string) (io.ReadCloser, error) {
+			if s, ok := sources[name]; ok {
+				return ioutil.NopCloser(strings.NewReader(s)), nil
+			}
+			return os.Open(filepath.Join(testdataDir, "..", name))
+		},
+		IncludeSourceCodeInfo: true,
+		LookupImport:          desc.LoadFileDescriptor,
+	}
+	names := []string{}
+	for n := range sources {
+		names = append(names, n)
+	}
+	files, err := p.ParseFiles(names...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+// generate runs the generator with params on the proto files of sources,
+// keyed by name, and returns the content of the generated files by name.
+func generate(t *testing.T, sources map[string]string, params *Parameters) (map[string]string, error) {
+	t.Helper()
+	files := parseFiles(t, sources)
+	sort.Slice(files, func(i, j int) bool { return files[i].GetName() < files[j].GetName() })
+	g := New()
+	g.Request = fileRequest(files...)
+	if params.OutputNamePattern == "" {
+		params.OutputNamePattern = defaultPattern
+	}
+	if err := g.GenerateAllFiles(params); err != nil {
+		return nil, err
+	}
+	result := map[string]string{}
+	for _, f := range g.Response.File {
+		result[f.GetName()] = f.GetContent()
+	}
+	return result, nil
+}
+
+// checkError fails t if err does not contain want, or is not nil if want is
+// empty.
+func checkError(t *testing.T, err error, want string) {
+	t.Helper()
+	switch {
+	case want == "" && err != nil:
+		t.Errorf("got error %q, want none", err)
+	case want != "" && err == nil:
+		t.Errorf("got no error, want %q", want)
+	case want != "" && !strings.Contains(err.Error(), want):
+		t.Errorf("got error %q, want %q", err, want)
+	}
+}
+
+func TestCheckNameCollisions(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		err    string
+	}{
+		{"distinct", `syntax = "proto3"; package col; message A { message B {} } message C {}`, ""},
+		{"nested and flat", `syntax = "proto3"; package col; message A { message B {} } message A_B {}`,
+			"col.A.B and col.A_B are both generated as A_B"},
+		{"nested enum", `syntax = "proto3"; package col; message A { enum B { B_UNSPECIFIED = 0; } } enum A_B { A_B_UNSPECIFIED = 0; }`,
+			"col.A_B and col.A.B are both generated as A_B"},
+		{"deeply nested", `syntax = "proto3"; package col; message A { message B { message C {} } message B_C {} }`,
+			"col.A.B.C and col.A.B_C are both generated as A_B_C"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := parseFiles(t, map[string]string{"col.proto": tt.source})
+			checkError(t, checkNameCollisions(files), tt.err)
+		})
+	}
+}
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"RouteGuide", "route-guide"},
+		{"route_guide", "route-guide"},
+		{"routeGuide", "route-guide"},
+		{"route-guide", "route-guide"},
+		{"RouteGuideV2", "route-guide-v2"},
+		{"v1Beta", "v1-beta"},
+		{"HTTPServer", "http-server"},
+		{"GetHTTP", "get-http"},
+		{"__route__guide__", "route-guide"},
+		{"google.api/http", "google-api-http"},
+		{"Éclair", "éclair"},
+		{"crèmeBrûlée", "crème-brûlée"},
+	}
+	for _, tt := range tests {
+		if got := slug(tt.in); got != tt.want {
+			t.Errorf("slug(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultFieldOptionsFunc(t *testing.T) {
+	const header = `syntax = "proto3"; package o; import "opts/opts.proto"; import "google/protobuf/any.proto"; `
+	tests := []struct {
+		name     string
+		source   string
+		required bool
+		int64    Int64Representation
+	}{
+		{"no options", `message M { int64 x = 1; }`, false, ""},
+		{"field required", `message M { int64 x = 1 [(opts.field).required = true]; }`, true, ""},
+		{"default required", `message M { option (opts.field_defaults).required = true; int64 x = 1; }`, true, ""},
+		{"default required with field int64", `message M { option (opts.field_defaults).required = true; int64 x = 1 [(opts.field).int64 = INT64_STRING]; }`,
+			true, Int64String},
+		{"default required with field any_types", `message M { option (opts.field_defaults).required = true; google.protobuf.Any x = 1 [(opts.field).any_types = "o.M"]; }`,
+			true, ""},
+		{"default required overridden", `message M { option (opts.field_defaults).required = true; int64 x = 1 [(opts.field).required = false]; }`,
+			false, ""},
+		{"default int64 overridden", `message M { option (opts.field_defaults).int64 = INT64_NUMBER; int64 x = 1 [(opts.field).int64 = INT64_STRING_NUMBER]; }`,
+			false, Int64StringNumber},
+		{"default int64", `message M { option (opts.field_defaults).int64 = INT64_NUMBER; int64 x = 1 [(opts.field).required = true]; }`,
+			true, Int64Number},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := parseFiles(t, map[string]string{"o.proto": header + tt.source})
+			f := files[0].FindMessage("o.M").FindFieldByName("x")
+			got := fieldOptions(f, &Parameters{})
+			if got.IsRequired != tt.required || got.Int64 != tt.int64 {
+				t.Errorf("got required %v and int64 %q, want %v and %q", got.IsRequired, got.Int64, tt.required, tt.int64)
+			}
+		})
+	}
+}
+
+func TestIsRequired(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		// required is the expected result, withDefaults the one with
+		// EmitDefaults set.
+		required, withDefaults bool
+	}{
+		{"proto3 scalar", `syntax = "proto3"; package p; message M { int32 x = 1; }`, false, true},
+		{"proto3 optional", `syntax = "proto3"; package p; message M { optional int32 x = 1; }`, false, false},
+		{"proto3 message", `syntax = "proto3"; package p; message M { M x = 1; }`, false, false},
+		{"proto3 oneof member", `syntax = "proto3"; package p; message M { oneof o { int32 x = 1; } }`, false, false},
+		{"proto3 repeated", `syntax = "proto3"; package p; message M { repeated int32 x = 1; }`, false, true},
+		{"proto3 map", `syntax = "proto3"; package p; message M { map<string, int32> x = 1; }`, false, true},
+		{"proto2 optional", `syntax = "proto2"; package p; message M { optional int32 x = 1; }`, false, false},
+		{"proto2 required", `syntax = "proto2"; package p; message M { required int32 x = 1; }`, true, true},
+		{"field option", `syntax = "proto3"; package p; import "opts/opts.proto"; message M { optional int32 x = 1 [(opts.field).required = true]; }`, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := parseFiles(t, map[string]string{"p.proto": tt.source})
+			f := files[0].FindMessage("p.M").FindFieldByName("x")
+			for _, emitDefaults := range []bool{false, true} {
+				params := &Parameters{EmitDefaults: emitDefaults}
+				want := tt.required
+				if emitDefaults {
+					want = tt.withDefaults
+				}
+				if got := isRequired(f, fieldOptions(f, params), params); got != want {
+					t.Errorf("isRequired with emit_defaults %v = %v, want %v", emitDefaults, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestJSONCodecsRequirements(t *testing.T) {
+	const source = `syntax = "proto3"; package j; message M { bytes b = 1; }`
+	tests := []struct {
+		name   string
+		params Parameters
+		err    string
+	}{
+		{"es_modules and wkt_json", Parameters{JSONCodecs: true, ESModules: true, WellKnownTypesAsJSON: true}, ""},
+		{"without wkt_json", Parameters{JSONCodecs: true, ESModules: true}, "json_codecs requires es_modules and wkt_json"},
+		{"without es_modules", Parameters{JSONCodecs: true, WellKnownTypesAsJSON: true}, "json_codecs requires es_modules and wkt_json"},
+		{"without codecs", Parameters{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			_, err := generate(t, map[string]string{"j.proto": source}, &params)
+			checkError(t, err, tt.err)
+		})
+	}
+}
+
+func TestBundle(t *testing.T) {
+	sources := map[string]string{
+		"a.proto": `syntax = "proto3"; package a; import "b.proto"; message A { b.B b = 1; }`,
+		"b.proto": `syntax = "proto3"; package b; message B {}`,
+	}
+	tests := []struct {
+		name   string
+		params Parameters
+		want   []string
+	}{
+		{"namespaces", Parameters{DeclareNamespace: true, Bundle: "protos.d.ts"},
+			[]string{"declare namespace a {", "b?: b.B;", "declare namespace b {"}},
+		{"es modules", Parameters{DeclareNamespace: true, ESModules: true, Bundle: "protos.ts"},
+			[]string{"export namespace a {", "b?: b.B;", "export namespace b {"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			files, err := generate(t, sources, &params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) != 1 {
+				t.Fatalf("got %d files, want 1", len(files))
+			}
+			got := files[tt.params.Bundle]
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("%s does not contain %q:\n%s", tt.params.Bundle, w, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,376 @@
+package gentstypes
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata/output")
+
+const (
+	testdataDir      = "../testdata"
+	defaultPattern   = `{{.Dir}}/{{.Descriptor.GetPackage | default "none"}}.{{.BaseName}}.d.ts`
+	modulePattern    = `{{.Dir}}/{{.Descriptor.GetPackage | default "none"}}.{{.BaseName}}.ts`
+	wellKnownTypeDir = "src/google/protobuf"
+)
+
+// wellKnownTypeFiles are generated like the files in testdata, examples.sh
+// links them from a protobuf checkout.
+var wellKnownTypeFiles = []string{"any.proto", "duration.proto", "empty.proto", "struct.proto", "timestamp.proto", "wrappers.proto"}
+
+// goldenConfigs mirror the protoc invocations of examples.sh, dir is the
+// directory in testdata/output holding the expected output. Bundles, and the
+// configurations in singleRequest, are generated from a single request for
+// the files in testdata.
+var goldenConfigs = []struct {
+	dir    string
+	params func(p *Parameters)
+}{
+	{"defaults", func(p *Parameters) {}},
+	{"int-enums", func(p *Parameters) { p.EnumsAsInt = true }},
+	{"camel-case-names", func(p *Parameters) { p.OriginalNames = false }},
+	{"outpattern-1", func(p *Parameters) { p.OutputNamePattern = `{{.Dir}}/{{.BaseName}}.d.ts` }},
+	{"outpattern-2", func(p *Parameters) {
+		p.OutputNamePattern = `{{.Descriptor.GetPackage | replace "." "/"}}/{{.BaseName}}.d.ts`
+	}},
+	{"outpattern-3", func(p *Parameters) { p.OutputNamePattern = `{{.Dir}}/{{.BaseName}}pb.d.ts` }},
+	{"outpattern-4", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules = true
+		p.OutputNamePattern = `{{with .Options.GetJavaPackage}}{{replace "." "/" .}}{{else}}{{$.Descriptor.GetPackage | default "none" | replace "." "/"}}{{end}}/{{with .Services}}{{(index . 0).GetName | slug}}{{else}}{{$.BaseName | slug}}{{end}}.ts`
+	}},
+	{"wo-namespace", func(p *Parameters) { p.DeclareNamespace = false }},
+	{"async-iterators", func(p *Parameters) { p.AsyncIterators = true }},
+	{"oneof-unions", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.OneofsAsUnions = true
+	}},
+	{"emit-defaults", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.EmitDefaults = true
+	}},
+	{"es-modules", func(p *Parameters) {
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+	}},
+	{"json-codecs", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs = true
+	}},
+	{"http-client", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs, p.HTTPClient = true, true
+	}},
+	{"nested-namespaces", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.NestedNamespaces = true
+	}},
+	{"int64-bigint", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs = true
+		p.Int64 = Int64BigInt
+	}},
+	{"json-schema", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.JSONSchema = true
+	}},
+	{"zod", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.OneofsAsUnions, p.Zod = true, true
+	}},
+	{"import-mapping", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs = true
+		p.ImportMap = map[string]string{"route_guide.proto": "@example/protos/routeguide"}
+	}},
+	{"dependencies", func(p *Parameters) {
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.Dependencies, p.DependencyDeny = true, []string{"nested"}
+	}},
+	{"jsdoc", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs, p.JSDoc, p.HTTPClient = true, true, true
+	}},
+	{"enum-union", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.EnumStyle, p.OmitEnumZeros = EnumStyleUnion, true
+	}},
+	{"enum-const-object", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs, p.Zod = true, true
+		p.EnumStyle, p.StripEnumPrefix = EnumStyleConstObject, true
+	}},
+	{"enum-const-enum", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs, p.Zod, p.EnumsAsInt = true, true, true
+		p.EnumStyle, p.StripEnumPrefix = EnumStyleConstEnum, true
+	}},
+	{"grpc-web", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs, p.GRPCWeb = true, true
+	}},
+	{"call-options", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs, p.HTTPClient, p.GRPCWeb, p.CallOptions = true, true, true, true
+	}},
+	{"server-handlers", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.ServerHandlers, p.InputTypes = true, true
+	}},
+	{"input-types", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs, p.HTTPClient, p.Zod, p.InputTypes = true, true, true, true
+	}},
+	{"templates", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.EnumStyle, p.InputTypes = EnumStyleUnion, true
+		p.TemplateDir = filepath.Join(testdataDir, "templates")
+	}},
+	{"status-details", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs, p.HTTPClient, p.StatusDetails = true, true, true
+		p.StatusDetailTypes = []string{"shelves.ShelfFull"}
+	}},
+	{"any-types", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.AnyTypes = true
+	}},
+	{"any-types-es-modules", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs, p.Zod, p.AnyTypes = true, true, true
+	}},
+	{"bundle", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.Bundle = "protos.d.ts"
+	}},
+	{"bundle-es-modules", func(p *Parameters) {
+		p.WellKnownTypesAsJSON = true
+		p.ESModules, p.OutputNamePattern = true, modulePattern
+		p.JSONCodecs, p.HTTPClient, p.Zod = true, true, true
+		p.Bundle = "protos.ts"
+	}},
+}
+
+// singleRequest holds the configurations that need the files of other
+// requests, status_detail names a message of shelves.proto.
+var singleRequest = map[string]bool{"status-details": true}
+
+// TestGolden generates the files in testdata for each configuration and
+// compares them with the files in testdata/output, which must not hold other
+// files. Run with -update to rewrite them and remove the others. The
+// well-known type files are only compared if PROTOBUF_ROOT is set to a
+// protobuf checkout, as in examples.sh.
+func TestGolden(t *testing.T) {
+	requests := goldenRequests(t)
+	unchecked := map[string]bool{}
+	if os.Getenv("PROTOBUF_ROOT") == "" {
+		t.Log("PROTOBUF_ROOT is not set, the well-known type files are not compared")
+		// Their comments are only in the proto files, the linked descriptors
+		// give their output names. examples.sh links them into testdata.
+		for _, n := range wellKnownTypeFiles {
+			fd, err := desc.LoadFileDescriptor("google/protobuf/" + n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			file := proto.Clone(fd.AsFileDescriptorProto()).(*descriptor.FileDescriptorProto)
+			file.Name = proto.String(n)
+			requests = append(requests, &plugin.CodeGeneratorRequest{FileToGenerate: []string{n}, ProtoFile: []*descriptor.FileDescriptorProto{file}})
+			unchecked[n] = true
+		}
+	}
+	for _, c := range goldenConfigs {
+		c := c
+		t.Run(c.dir, func(t *testing.T) {
+			params := &Parameters{
+				DeclareNamespace:  true,
+				OriginalNames:     true,
+				OutputNamePattern: defaultPattern,
+			}
+			c.params(params)
+			reqs := requests
+			if params.Bundle != "" || singleRequest[c.dir] {
+				reqs = []*plugin.CodeGeneratorRequest{bundleRequest(requests)}
+			}
+			dir := filepath.Join(testdataDir, "output", c.dir)
+			generated := map[string]bool{}
+			for _, req := range reqs {
+				g := New()
+				g.Request = req
+				if err := g.GenerateAllFiles(params); err != nil {
+					t.Errorf("%s: %v", req.FileToGenerate[0], err)
+					continue
+				}
+				for _, f := range g.Response.File {
+					path := filepath.Join(dir, f.GetName())
+					generated[path] = true
+					if !unchecked[req.FileToGenerate[0]] {
+						checkGolden(t, path, f.GetContent())
+					}
+				}
+			}
+			checkOrphans(t, dir, generated)
+		})
+	}
+}
+
+// checkOrphans fails t for the files in dir that are not generated, or
+// removes them with -update.
+func checkOrphans(t *testing.T, dir string, generated map[string]bool) {
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || generated[path] {
+			return err
+		}
+		if *update {
+			return os.Remove(path)
+		}
+		t.Errorf("%s is not generated", path)
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// goldenRequests parses the proto files in testdata, and the well-known type
+// files if available, and returns a request per file as protoc would send
+// it. Imports not found in testdata are resolved from the descriptors linked
+// into the test.
+func goldenRequests(t *testing.T) []*plugin.CodeGeneratorRequest {
+	files, err := filepath.Glob(filepath.Join(testdataDir, "*.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root := os.Getenv("PROTOBUF_ROOT"); root != "" {
+		for _, n := range wellKnownTypeFiles {
+			files = append(files, filepath.Join(root, wellKnownTypeDir, n))
+		}
+	}
+	requests := []*plugin.CodeGeneratorRequest{}
+	seen := map[string]bool{}
+	for _, path := range files {
+		name := filepath.Base(path)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		p := protoparse.Parser{
+			ImportPaths:           []string{filepath.Dir(path), testdataDir, filepath.Join(testdataDir, "..")},
+			IncludeSourceCodeInfo: true,
+			LookupImport:          desc.LoadFileDescriptor,
+		}
+		fds, err := p.ParseFiles(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		requests = append(requests, fileRequest(fds...))
+	}
+	sort.Slice(requests, func(i, j int) bool { return requests[i].FileToGenerate[0] < requests[j].FileToGenerate[0] })
+	return requests
+}
+
+// fileRequest returns the request protoc sends to generate files, holding
+// them and their dependencies in topological order.
+func fileRequest(files ...*desc.FileDescriptor) *plugin.CodeGeneratorRequest {
+	req := &plugin.CodeGeneratorRequest{}
+	added := map[*desc.FileDescriptor]bool{}
+	var add func(fd *desc.FileDescriptor)
+	add = func(fd *desc.FileDescriptor) {
+		if added[fd] {
+			return
+		}
+		added[fd] = true
+		for _, d := range fd.GetDependencies() {
+			add(d)
+		}
+		req.ProtoFile = append(req.ProtoFile, fd.AsFileDescriptorProto())
+	}
+	for _, fd := range files {
+		req.FileToGenerate = append(req.FileToGenerate, fd.GetName())
+		add(fd)
+	}
+	return req
+}
+
+// bundleRequest merges the requests for the files in testdata into one
+// request, leaving out the well-known type files so that the bundle does not
+// depend on PROTOBUF_ROOT.
+func bundleRequest(requests []*plugin.CodeGeneratorRequest) *plugin.CodeGeneratorRequest {
+	wkt := map[string]bool{}
+	for _, n := range wellKnownTypeFiles {
+		wkt[n] = true
+	}
+	bundle := &plugin.CodeGeneratorRequest{}
+	seen := map[string]bool{}
+	for _, req := range requests {
+		if wkt[req.FileToGenerate[0]] {
+			continue
+		}
+		bundle.FileToGenerate = append(bundle.FileToGenerate, req.FileToGenerate[0])
+		for _, fd := range req.ProtoFile {
+			if !seen[fd.GetName()] {
+				seen[fd.GetName()] = true
+				bundle.ProtoFile = append(bundle.ProtoFile, fd)
+			}
+		}
+	}
+	return bundle
+}
+
+func checkGolden(t *testing.T, path, got string) {
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Errorf("%s: %v", path, err)
+		return
+	}
+	if string(want) == got {
+		return
+	}
+	wantLines, gotLines := strings.Split(string(want), "\n"), strings.Split(got, "\n")
+	for i := 0; ; i++ {
+		if i >= len(wantLines) || i >= len(gotLines) || wantLines[i] != gotLines[i] {
+			t.Errorf("%s differs at line %d:\nwant: %s\ngot:  %s", path, i+1, line(wantLines, i), line(gotLines, i))
+			return
+		}
+	}
+}
+
+func line(lines []string, i int) string {
+	if i >= len(lines) {
+		return "<EOF>"
+	}
+	return lines[i]
+}
@@ -0,0 +1,276 @@
+package gentstypes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+// The grpc-web clients call the methods of a service through a grpc-web proxy
+// such as Envoy. Requests and responses are length-prefixed frames, the
+// status of the call is in the trailers frame ending the response or, for
+// trailers-only responses, in the headers. The payload of the frames is
+// encoded by a GRPCWebCodec, grpcWebJSON for application/grpc-web+json by
+// default. The generator does not emit binary serializers, for
+// application/grpc-web+proto the codec is supplied by the caller, e.g. on top
+// of protobufjs, and receives the fully qualified message type names.
+// Messages are passed to codecs in their JSON representation, converted with
+// the JSON codecs if they are generated.
+
+const webClientSuffix = "WebClient"
+
+// grpcWebHelpers holds the module private declarations used by the generated
+// grpc-web clients, they are only written to files that use them.
+var grpcWebHelpers = map[string]string{
+	"GRPCWebFetch": `type GRPCWebFetch = (input: string, init: RequestInit) => Promise<Response>;`,
+	"GRPCWebCodec": `interface GRPCWebCodec {
+    // contentType is the grpc-web content type, e.g. application/grpc-web+proto.
+    contentType: string;
+    encode(type: string, m: any): Uint8Array;
+    decode(type: string, data: Uint8Array): any;
+}`,
+	"grpcWebJSON": `const grpcWebJSON: GRPCWebCodec = {
+    contentType: "application/grpc-web+json",
+    encode: (type: string, m: any) => new TextEncoder().encode(JSON.stringify(m)),
+    decode: (type: string, data: Uint8Array) => JSON.parse(new TextDecoder().decode(data)),
+};`,
+	"grpcWebCall": `async function* grpcWebCall(fetch: GRPCWebFetch, baseURL: string, path: string, codec: GRPCWebCodec, types: [string, string], r: any, opts: any = {}, meta: any = {}): AsyncGenerator<any> {
+    const payload = codec.encode(types[0], r);
+    const body = new Uint8Array(5 + payload.length);
+    new DataView(body.buffer).setUint32(1, payload.length);
+    body.set(payload, 5);
+    const url = baseURL + path;
+    const res = await fetch(url, {
+        method: "POST",
+        headers: { ...callHeaders(opts), "Content-Type": codec.contentType, "Accept": codec.contentType, "X-Grpc-Web": "1" },
+        body: body,
+        signal: callSignal(opts),
+    });
+    meta.headers = callMetadata(res.headers, "");
+    if (!res.ok) {
+        throw Object.assign(new Error(` + "`POST ${url}: ${res.status} ${res.statusText}`" + `), { status: res.status });
+    }
+    // Trailers-only responses carry the status in the headers.
+    let trailers = meta.headers;
+    if (res.headers.get("grpc-status") === null && res.body) {
+        trailers = {};
+        const reader = res.body.getReader();
+        let buf = new Uint8Array(0);
+        for (;;) {
+            while (buf.length >= 5) {
+                const n = new DataView(buf.buffer, buf.byteOffset).getUint32(1);
+                if (buf.length < 5 + n) {
+                    break;
+                }
+                const flags = buf[0];
+                const data = buf.slice(5, 5 + n);
+                buf = buf.slice(5 + n);
+                if (flags & 0x80) {
+                    trailers = grpcWebTrailers(data);
+                } else if (flags & 0x01) {
+                    throw new Error(` + "`POST ${url}: compressed grpc-web frames are not supported`" + `);
+                } else {
+                    yield codec.decode(types[1], data);
+                }
+            }
+            const { done, value } = await reader.read();
+            if (done) {
+                break;
+            }
+            const next = new Uint8Array(buf.length + value.length);
+            next.set(buf);
+            next.set(value, buf.length);
+            buf = next;
+        }
+    }
+    meta.trailers = trailers;
+    const status = (trailers["grpc-status"] || [])[0];
+    const message = decodeURIComponent((trailers["grpc-message"] || [])[0] || "");
+    if (status === undefined) {
+        meta.status = { code: 2, message: "missing grpc-status", details: [] };
+        throw Object.assign(new Error(` + "`POST ${url}: missing grpc-status`" + `), { code: 2 });
+    }
+    meta.status = { code: Number(status), message: message, details: grpcWebStatusDetails((trailers["grpc-status-details-bin"] || [])[0]) };
+    if (status !== "0") {
+        throw Object.assign(new Error(` + "`POST ${url}: ${status} ${message}`" + `), { code: Number(status), grpcMessage: message, details: meta.status.details });
+    }
+}`,
+	"grpcWebStatusDetails": `function grpcWebStatusDetails(bin: string | undefined): Array<{ "@type": string; value: string }> {
+    const details: Array<{ "@type": string; value: string }> = [];
+    if (!bin) {
+        return details;
+    }
+    // grpc-status-details-bin is a google.rpc.Status in base64, its details
+    // are the google.protobuf.Any messages of field 3.
+    const data = Uint8Array.from(atob(bin), (c) => c.charCodeAt(0));
+    for (const [n, any] of protoFields(data)) {
+        if (n !== 3) {
+            continue;
+        }
+        const d = { "@type": "", value: "" };
+        for (const [m, v] of protoFields(any)) {
+            if (m === 1) {
+                d["@type"] = new TextDecoder().decode(v);
+            } else if (m === 2) {
+                d.value = btoa(String.fromCharCode(...v));
+            }
+        }
+        details.push(d);
+    }
+    return details;
+}`,
+	"protoFields": `function protoFields(data: Uint8Array): Array<[number, Uint8Array]> {
+    const fields: Array<[number, Uint8Array]> = [];
+    let i = 0;
+    const varint = () => {
+        let v = 0;
+        for (let shift = 0; ; shift += 7) {
+            if (i >= data.length) {
+                throw new Error("truncated protobuf message");
+            }
+            const b = data[i++];
+            v += (b & 0x7f) * 2 ** shift;
+            if (b < 0x80) {
+                return v;
+            }
+        }
+    };
+    // Only the length-delimited fields are kept.
+    while (i < data.length) {
+        const key = varint();
+        switch (key & 7) {
+        case 0:
+            varint();
+            break;
+        case 1:
+            i += 8;
+            break;
+        case 2: {
+            const n = varint();
+            fields.push([Math.floor(key / 8), data.subarray(i, i + n)]);
+            i += n;
+            break;
+        }
+        case 5:
+            i += 4;
+            break;
+        default:
+            throw new Error("unsupported protobuf wire type " + (key & 7));
+        }
+    }
+    return fields;
+}`,
+	"grpcWebTrailers": `function grpcWebTrailers(data: Uint8Array): { [key: string]: Array<string> } {
+    const trailers: { [key: string]: Array<string> } = {};
+    for (const line of new TextDecoder().decode(data).split("\r\n")) {
+        const i = line.indexOf(":");
+        if (i > 0) {
+            const name = line.slice(0, i).trim().toLowerCase();
+            trailers[name] = (trailers[name] || []).concat(line.slice(i + 1).trim());
+        }
+    }
+    return trailers;
+}`,
+	"grpcWebUnary": `async function grpcWebUnary(responses: AsyncGenerator<any>): Promise<any> {
+    let result: any = undefined;
+    for await (const m of responses) {
+        if (result === undefined) {
+            result = m;
+        }
+    }
+    if (result === undefined) {
+        throw Object.assign(new Error("grpc-web call returned no response"), { code: 12 });
+    }
+    return result;
+}`,
+}
+
+// reserveGRPCWebNames reserves the names of the grpc-web client classes and
+// helpers declared by the file being generated.
+func (g *Generator) reserveGRPCWebNames(files []*desc.FileDescriptor) {
+	for _, f := range files {
+		for _, svc := range f.GetServices() {
+			g.imports.reserve(svc.GetName() + webClientSuffix)
+		}
+	}
+	for n := range grpcWebHelpers {
+		g.imports.reserve(n)
+	}
+}
+
+// hasGRPCWebMethods reports whether service has a method grpc-web can call,
+// browsers cannot stream requests.
+func hasGRPCWebMethods(service *desc.ServiceDescriptor) bool {
+	for _, m := range service.GetMethods() {
+		if !m.IsClientStreaming() {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Generator) generateGRPCWebClient(service *desc.ServiceDescriptor, params *Parameters) {
+	g.helper("GRPCWebFetch")
+	g.helper("GRPCWebCodec")
+	if !strings.HasSuffix(g.String(), "\n\n") {
+		g.W("")
+	}
+	g.wdoc(service, params)
+	g.W(fmt.Sprintf("export class %s {", service.GetName()+webClientSuffix))
+	g.incIndent()
+	g.W("private readonly baseURL: string;")
+	g.W("private readonly fetch: GRPCWebFetch;")
+	g.W("private readonly codec: GRPCWebCodec;\n")
+	g.W("// fetch defaults to the global fetch function and codec to JSON.")
+	g.W("constructor(baseURL: string, fetch?: GRPCWebFetch, codec?: GRPCWebCodec) {")
+	g.W(indent + "this.baseURL = baseURL.replace(/\\/+$/, \"\");")
+	g.W(indent + "this.fetch = fetch || ((input, init) => globalThis.fetch(input, init));")
+	g.W(indent + fmt.Sprintf("this.codec = codec || %s;", g.helper("grpcWebJSON")))
+	g.W("}")
+	for _, m := range service.GetMethods() {
+		if m.IsClientStreaming() {
+			continue
+		}
+		g.Buffer.WriteString("\n")
+		g.generateGRPCWebClientMethod(m, params)
+	}
+	g.decIndent()
+	g.W("}\n")
+}
+
+func (g *Generator) generateGRPCWebClientMethod(method *desc.MethodDescriptor, params *Parameters) {
+	in, out := method.GetInputType(), method.GetOutputType()
+	r, result := "r", "m"
+	if params.JSONCodecs {
+		r = fmt.Sprintf("%s(r)", g.requestCodecName(in, params))
+		result = fmt.Sprintf("%s(m)", g.codecName(out, fromJSONSuffix, params))
+	}
+	call := fmt.Sprintf("%s(this.fetch, this.baseURL, \"/%s/%s\", this.codec, [\"%s\", \"%s\"], %s",
+		g.helper("grpcWebCall"), method.GetService().GetFullyQualifiedName(), method.GetName(),
+		in.GetFullyQualifiedName(), out.GetFullyQualifiedName(), r)
+	g.helper("grpcWebTrailers")
+	g.helper("grpcWebStatusDetails")
+	g.helper("protoFields")
+	g.helper("callHeaders")
+	g.helper("callSignal")
+	g.helper("callMetadata")
+	if params.CallOptions {
+		g.generateCallMethod(method, call+", opts, meta)", result, g.helper("grpcWebUnary")+"(%s)", params)
+		return
+	}
+	call += ")"
+	g.wdoc(method, params)
+	if method.IsServerStreaming() {
+		g.W(fmt.Sprintf("async *%s(r: %s): AsyncGenerator<%s> {", method.GetName(), g.inputTypeName(in, params), g.typeName(out, params)))
+		g.W(indent + fmt.Sprintf("for await (const m of %s) {", call))
+		g.W(indent + indent + fmt.Sprintf("yield %s;", result))
+		g.W(indent + "}")
+		g.W("}")
+		return
+	}
+	g.W(fmt.Sprintf("async %s(r: %s): Promise<%s> {", method.GetName(), g.inputTypeName(in, params), g.typeName(out, params)))
+	g.W(indent + fmt.Sprintf("const m = await %s(%s);", g.helper("grpcWebUnary"), call))
+	g.W(indent + fmt.Sprintf("return %s;", result))
+	g.W("}")
+}
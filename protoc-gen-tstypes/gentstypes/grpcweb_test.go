@@ -0,0 +1,48 @@
+package gentstypes
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGRPCWebClient(t *testing.T) {
+	const header = `syntax = "proto3"; package w; message M {} `
+	tests := []struct {
+		name    string
+		service string
+		codecs  bool
+		want    []string
+		notWant []string
+	}{
+		{"unary", `service S { rpc Get(M) returns (M); }`, false,
+			[]string{"export class SWebClient {", "async Get(r: M): Promise<M> {", `grpcWebCall(this.fetch, this.baseURL, "/w.S/Get", this.codec, ["w.M", "w.M"], r)`}, nil},
+		{"server streaming", `service S { rpc Watch(M) returns (stream M); }`, false,
+			[]string{"async *Watch(r: M): AsyncGenerator<M> {"}, nil},
+		{"client streaming", `service S { rpc Get(M) returns (M); rpc Upload(stream M) returns (M); rpc Chat(stream M) returns (stream M); }`, false,
+			[]string{"async Get("}, []string{"Upload(", "Chat("}},
+		{"only client streaming", `service S { rpc Upload(stream M) returns (M); }`, false,
+			nil, []string{"SWebClient", "grpcWebCall"}},
+		{"json codecs", `service S { rpc Get(M) returns (M); }`, true,
+			[]string{`["w.M", "w.M"], MToJSON(r))`, "return MFromJSON(m);"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &Parameters{ESModules: true, WellKnownTypesAsJSON: true, JSONCodecs: tt.codecs, GRPCWeb: true, OutputNamePattern: "{{.BaseName}}.ts"}
+			files, err := generate(t, map[string]string{"w.proto": header + tt.service}, params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := files["w.ts"]
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("output does not contain %q:\n%s", w, got)
+				}
+			}
+			for _, w := range tt.notWant {
+				if strings.Contains(got, w) {
+					t.Errorf("output contains %q:\n%s", w, got)
+				}
+			}
+		})
+	}
+}
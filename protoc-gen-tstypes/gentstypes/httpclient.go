@@ -0,0 +1,341 @@
+package gentstypes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/api/annotations"
+)
+
+// The HTTP clients call the REST endpoints a grpc-gateway derives from the
+// google.api.http annotations. Each binding of a method is described by an
+// HTTPBinding value, the helpers below pick the first binding whose path
+// variables are all set on the request and map the remaining fields to the
+// request body and query parameters.
+
+const clientSuffix = "Client"
+
+// httpHelpers holds the module private declarations used by the generated
+// clients, they are only written to files that use them.
+var httpHelpers = map[string]string{
+	"HTTPFetch": `type HTTPFetch = (input: string, init: RequestInit) => Promise<Response>;`,
+	"HTTPBinding": `interface HTTPBinding {
+    method: string;
+    // path alternates literal parts and path variables.
+    path: Array<string | HTTPPathVariable>;
+    // body is "*" or the field sent as the request body.
+    body?: string;
+    // responseBody is the field the response body is decoded to.
+    responseBody?: string;
+}
+
+interface HTTPPathVariable {
+    field: Array<string>;
+    // multi is set if the variable matches several path segments.
+    multi: boolean;
+}`,
+	"httpCall": `async function httpCall(fetch: HTTPFetch, baseURL: string, bindings: Array<HTTPBinding>, r: any, opts: any = {}, meta: any = {}): Promise<any> {
+    for (const b of bindings) {
+        const rest = JSON.parse(JSON.stringify(r));
+        let path = "";
+        let matched = true;
+        for (const p of b.path) {
+            if (typeof p === "string") {
+                path += p;
+                continue;
+            }
+            const v = httpTakeField(rest, p.field);
+            if (v === undefined || v === null || v === "") {
+                matched = false;
+                break;
+            }
+            path += p.multi ? String(v).split("/").map(encodeURIComponent).join("/") : encodeURIComponent(String(v));
+        }
+        if (!matched) {
+            continue;
+        }
+        const init: RequestInit = { method: b.method, headers: { ...callHeaders(opts), "Accept": "application/json" }, signal: callSignal(opts) };
+        if (b.body) {
+            const body = b.body === "*" ? rest : httpTakeField(rest, [b.body]);
+            init.body = JSON.stringify(body === undefined ? {} : body);
+            init.headers = { ...callHeaders(opts), "Accept": "application/json", "Content-Type": "application/json" };
+        }
+        const url = baseURL + path + (b.body === "*" ? "" : httpQuery(rest));
+        const res = await fetch(url, init);
+        // grpc-gateway sends the trailers as headers with a prefix and the
+        // google.rpc.Status of failed calls as the body.
+        meta.headers = callMetadata(res.headers, "");
+        meta.trailers = callMetadata(res.headers, "grpc-trailer-");
+        const text = await res.text();
+        // Proxies may fail calls with bodies that are not JSON, such as HTML
+        // pages, they are kept as text.
+        let json: any = undefined;
+        try {
+            json = text ? JSON.parse(text) : {};
+        } catch (e) {
+            if (res.ok) {
+                throw Object.assign(new Error(` + "`${b.method} ${url}: invalid JSON response`" + `), { status: res.status, body: text });
+            }
+        }
+        meta.status = res.ok ? { code: 0, message: "", details: [] } : { code: json?.code ?? 2, message: json?.message ?? res.statusText, details: json?.details ?? [] };
+        if (!res.ok) {
+            throw Object.assign(new Error(` + "`${b.method} ${url}: ${res.status} ${res.statusText}`" + `), { status: res.status, body: json === undefined ? text : json });
+        }
+        return b.responseBody ? { [b.responseBody]: json } : json;
+    }
+    throw new Error("request does not match any HTTP binding");
+}`,
+	"httpTakeField": `function httpTakeField(r: any, field: Array<string>): any {
+    for (let i = 0; i < field.length - 1 && r != null; i++) {
+        r = r[field[i]];
+    }
+    if (r == null) {
+        return undefined;
+    }
+    const name = field[field.length - 1];
+    const v = r[name];
+    delete r[name];
+    return v;
+}`,
+	"httpQuery": `function httpQuery(r: any): string {
+    const q = new URLSearchParams();
+    const add = (name: string, v: any) => {
+        if (v === undefined || v === null) {
+            return;
+        }
+        if (Array.isArray(v)) {
+            v.forEach((e) => add(name, e));
+        } else if (typeof v === "object") {
+            Object.keys(v).forEach((k) => add(name ? name + "." + k : k, v[k]));
+        } else {
+            q.append(name, String(v));
+        }
+    };
+    add("", r);
+    const s = q.toString();
+    return s ? "?" + s : "";
+}`,
+}
+
+// reserveHTTPClientNames reserves the names of the client classes and
+// helpers declared by the file being generated.
+func (g *Generator) reserveHTTPClientNames(files []*desc.FileDescriptor) {
+	for _, f := range files {
+		for _, svc := range f.GetServices() {
+			g.imports.reserve(svc.GetName() + clientSuffix)
+		}
+	}
+	for n := range httpHelpers {
+		g.imports.reserve(n)
+	}
+	g.imports.reserve("HTTPPathVariable")
+}
+
+// httpBinding is an HTTP endpoint of a method, one of the bindings of its
+// google.api.http rule.
+type httpBinding struct {
+	method       string
+	path         string
+	body         string
+	responseBody string
+}
+
+// httpBindings returns the bindings of the google.api.http rule of method,
+// the primary binding first. It returns nil if method has no rule.
+func httpBindings(method *desc.MethodDescriptor) []httpBinding {
+	o := method.GetMethodOptions()
+	if o == nil || !proto.HasExtension(o, annotations.E_Http) {
+		return nil
+	}
+	e, err := proto.GetExtension(o, annotations.E_Http)
+	if err != nil {
+		return nil
+	}
+	rule, ok := e.(*annotations.HttpRule)
+	if !ok {
+		return nil
+	}
+	result := []httpBinding{}
+	for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+		b := httpBinding{body: r.GetBody(), responseBody: r.GetResponseBody()}
+		switch p := r.GetPattern().(type) {
+		case *annotations.HttpRule_Get:
+			b.method, b.path = "GET", p.Get
+		case *annotations.HttpRule_Put:
+			b.method, b.path = "PUT", p.Put
+		case *annotations.HttpRule_Post:
+			b.method, b.path = "POST", p.Post
+		case *annotations.HttpRule_Delete:
+			b.method, b.path = "DELETE", p.Delete
+		case *annotations.HttpRule_Patch:
+			b.method, b.path = "PATCH", p.Patch
+		case *annotations.HttpRule_Custom:
+			b.method, b.path = p.Custom.GetKind(), p.Custom.GetPath()
+		default:
+			continue
+		}
+		result = append(result, b)
+	}
+	return result
+}
+
+// hasHTTPBindings reports whether service has a method the HTTP client can
+// call.
+func hasHTTPBindings(service *desc.ServiceDescriptor) bool {
+	for _, m := range service.GetMethods() {
+		if len(httpBindings(m)) > 0 && !m.IsClientStreaming() && !m.IsServerStreaming() {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Generator) generateHTTPClient(service *desc.ServiceDescriptor, params *Parameters) error {
+	name := service.GetName() + clientSuffix
+	g.helper("HTTPFetch")
+	g.W("")
+	g.wdoc(service, params)
+	g.W(fmt.Sprintf("export class %s {", name))
+	g.incIndent()
+	g.W("private readonly baseURL: string;")
+	g.W("private readonly fetch: HTTPFetch;\n")
+	g.W("// fetch defaults to the global fetch function.")
+	g.W("constructor(baseURL: string, fetch?: HTTPFetch) {")
+	g.W(indent + "this.baseURL = baseURL.replace(/\\/+$/, \"\");")
+	g.W(indent + "this.fetch = fetch || ((input, init) => globalThis.fetch(input, init));")
+	g.W("}")
+	for _, m := range service.GetMethods() {
+		bindings := httpBindings(m)
+		// Streaming methods are not supported over the REST mapping.
+		if len(bindings) == 0 || m.IsClientStreaming() || m.IsServerStreaming() {
+			continue
+		}
+		g.Buffer.WriteString("\n")
+		if err := g.generateHTTPClientMethod(m, bindings, params); err != nil {
+			return errors.Wrap(err, m.GetFullyQualifiedName())
+		}
+	}
+	g.decIndent()
+	g.W("}\n")
+	return nil
+}
+
+func (g *Generator) generateHTTPClientMethod(method *desc.MethodDescriptor, bindings []httpBinding, params *Parameters) error {
+	in, out := method.GetInputType(), method.GetOutputType()
+	g.wdoc(method, params)
+	r := fmt.Sprintf("%s(r)", g.requestCodecName(in, params))
+	result := fmt.Sprintf("%s(json)", g.codecName(out, fromJSONSuffix, params))
+	if params.CallOptions {
+		g.W(fmt.Sprintf("async %s(r: %s, opts?: %s): %s {", method.GetName(), g.inputTypeName(in, params), g.helper("CallOptions"), g.callResult(method, params)))
+		g.W(indent + "const meta: any = {};")
+		g.W(indent + "try {")
+		g.incIndent()
+		r += ", opts, meta"
+	} else {
+		g.W(fmt.Sprintf("async %s(r: %s): Promise<%s> {", method.GetName(), g.inputTypeName(in, params), g.typeName(out, params)))
+	}
+	g.W(indent + fmt.Sprintf("const json = await %s(this.fetch, this.baseURL, [", g.helper("httpCall")))
+	g.helper("HTTPBinding")
+	g.helper("httpTakeField")
+	g.helper("httpQuery")
+	g.helper("callHeaders")
+	g.helper("callSignal")
+	g.helper("callMetadata")
+	for _, b := range bindings {
+		l, err := g.httpBindingLiteral(method, b, params)
+		if err != nil {
+			return err
+		}
+		g.W(indent + indent + l + ",")
+	}
+	g.W(indent + fmt.Sprintf("], %s);", r))
+	if params.CallOptions {
+		result = callResponse(result)
+	}
+	g.W(indent + fmt.Sprintf("return %s;", result))
+	if params.CallOptions {
+		g.decIndent()
+		g.W(indent + "} catch (e) {")
+		g.W(indent + indent + fmt.Sprintf("throw %s(e, meta);", g.helper("callError")))
+		g.W(indent + "}")
+	}
+	g.W("}")
+	return nil
+}
+
+// httpBindingLiteral returns the HTTPBinding object literal describing b.
+func (g *Generator) httpBindingLiteral(method *desc.MethodDescriptor, b httpBinding, params *Parameters) (string, error) {
+	in := method.GetInputType()
+	parts := []string{}
+	path := b.path
+	for path != "" {
+		start := strings.Index(path, "{")
+		if start < 0 {
+			parts = append(parts, fmt.Sprintf("%q", path))
+			break
+		}
+		end := strings.Index(path[start:], "}")
+		if end < 0 {
+			return "", errors.Errorf("unterminated variable in HTTP path %q", b.path)
+		}
+		end += start
+		if start > 0 {
+			parts = append(parts, fmt.Sprintf("%q", path[:start]))
+		}
+		variable := path[start+1 : end]
+		pattern := "*"
+		if i := strings.Index(variable, "="); i >= 0 {
+			variable, pattern = variable[:i], variable[i+1:]
+		}
+		names, err := httpFieldPath(in, variable, params)
+		if err != nil {
+			return "", err
+		}
+		field := []string{}
+		for _, n := range names {
+			field = append(field, fmt.Sprintf("%q", n))
+		}
+		multi := strings.Contains(pattern, "/") || strings.Contains(pattern, "**")
+		parts = append(parts, fmt.Sprintf("{ field: [%s], multi: %v }", strings.Join(field, ", "), multi))
+		path = path[end+1:]
+	}
+	result := fmt.Sprintf("{ method: %q, path: [%s]", b.method, strings.Join(parts, ", "))
+	if b.body == "*" {
+		result += ", body: \"*\""
+	} else if b.body != "" {
+		names, err := httpFieldPath(in, b.body, params)
+		if err != nil {
+			return "", err
+		}
+		result += fmt.Sprintf(", body: %q", names[0])
+	}
+	if b.responseBody != "" {
+		names, err := httpFieldPath(method.GetOutputType(), b.responseBody, params)
+		if err != nil {
+			return "", err
+		}
+		result += fmt.Sprintf(", responseBody: %q", names[0])
+	}
+	return result + " }", nil
+}
+
+// httpFieldPath resolves the dot separated field path p of the message m to
+// the property names of the generated types.
+func httpFieldPath(m *desc.MessageDescriptor, p string, params *Parameters) ([]string, error) {
+	result := []string{}
+	for i, n := range strings.Split(p, ".") {
+		if m == nil {
+			return nil, errors.Errorf("HTTP field path %q: %s is not a message", p, strings.Join(strings.Split(p, ".")[:i], "."))
+		}
+		f := m.FindFieldByName(n)
+		if f == nil {
+			return nil, errors.Errorf("HTTP field path %q: no field %s in %s", p, n, m.GetFullyQualifiedName())
+		}
+		result = append(result, fieldName(f, params))
+		m = f.GetMessageType()
+	}
+	return result, nil
+}
@@ -0,0 +1,208 @@
+package gentstypes
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+// importSet tracks the names a module imports from other generated files and
+// the local names they are bound to.
+type importSet struct {
+	// names holds the local names in use, either by an import or by a
+	// declaration in the module itself.
+	names   map[string]bool
+	entries map[importKey]*importEntry
+	byFile  map[*desc.FileDescriptor][]importKey
+	files   []*desc.FileDescriptor
+	// packages holds the names imported from packages other than the
+	// generated files, by module specifier.
+	packages map[string][]string
+}
+
+type importKey struct {
+	file *desc.FileDescriptor
+	name string
+}
+
+type importEntry struct {
+	local string
+	// value is set if the name is used as a value and not only as a type.
+	value bool
+}
+
+func newImportSet(files []*desc.FileDescriptor) *importSet {
+	s := &importSet{
+		names:    map[string]bool{},
+		entries:  map[importKey]*importEntry{},
+		byFile:   map[*desc.FileDescriptor][]importKey{},
+		packages: map[string][]string{},
+	}
+	for _, f := range files {
+		for _, d := range fileTypes(f) {
+			s.reserve(packageQualifiedName(d))
+		}
+		for _, svc := range f.GetServices() {
+			s.reserve(svc.GetName() + "Service")
+		}
+	}
+	return s
+}
+
+// fileTypes returns the enums and messages declared in f, including nested
+// ones.
+func fileTypes(f *desc.FileDescriptor) []desc.Descriptor {
+	result := []desc.Descriptor{}
+	var add func(m *desc.MessageDescriptor)
+	add = func(m *desc.MessageDescriptor) {
+		result = append(result, m)
+		for _, e := range m.GetNestedEnumTypes() {
+			result = append(result, e)
+		}
+		for _, n := range m.GetNestedMessageTypes() {
+			add(n)
+		}
+	}
+	for _, e := range f.GetEnumTypes() {
+		result = append(result, e)
+	}
+	for _, m := range f.GetMessageTypes() {
+		add(m)
+	}
+	return result
+}
+
+// reserve marks name as declared by the module itself, so imports are never
+// bound to it.
+func (s *importSet) reserve(name string) {
+	s.names[name] = true
+}
+
+// addName imports name from the file generated for f. Names that clash with
+// another import or a local declaration are aliased with the package name.
+func (s *importSet) addName(f *desc.FileDescriptor, name string, value bool) string {
+	key := importKey{f, name}
+	if e, ok := s.entries[key]; ok {
+		e.value = e.value || value
+		return e.local
+	}
+	prefix := strings.Replace(f.GetPackage(), ".", "_", -1)
+	local := name
+	if s.names[local] {
+		local = prefix + "_" + name
+	}
+	for i := 2; s.names[local]; i++ {
+		local = fmt.Sprintf("%s_%s%d", prefix, name, i)
+	}
+	s.names[local] = true
+	s.entries[key] = &importEntry{local: local, value: value}
+	if _, ok := s.byFile[f]; !ok {
+		s.files = append(s.files, f)
+	}
+	s.byFile[f] = append(s.byFile[f], key)
+	return local
+}
+
+// addPackage imports name from the package module, such as a runtime
+// library. The name is reserved as is.
+func (s *importSet) addPackage(module, name string) {
+	s.reserve(name)
+	s.packages[module] = append(s.packages[module], name)
+}
+
+// importStatements renders the import statements of the module, type-only
+// imports are kept separate from value imports. Package imports come first,
+// the module paths of files are computed by modulePath.
+func (s *importSet) importStatements(modulePath func(*desc.FileDescriptor) (string, error)) ([]string, error) {
+	type stmt struct{ path, text string }
+	stmts := []stmt{}
+	for _, f := range s.files {
+		types, values := []string{}, []string{}
+		for _, key := range s.byFile[f] {
+			e := s.entries[key]
+			spec := key.name
+			if key.name != e.local {
+				spec = fmt.Sprintf("%s as %s", key.name, e.local)
+			}
+			if e.value {
+				values = append(values, spec)
+			} else {
+				types = append(types, spec)
+			}
+		}
+		p, err := modulePath(f)
+		if err != nil {
+			return nil, err
+		}
+		if len(types) > 0 {
+			sort.Strings(types)
+			stmts = append(stmts, stmt{p, fmt.Sprintf("import type { %s } from \"%s\";", strings.Join(types, ", "), p)})
+		}
+		if len(values) > 0 {
+			sort.Strings(values)
+			stmts = append(stmts, stmt{p, fmt.Sprintf("import { %s } from \"%s\";", strings.Join(values, ", "), p)})
+		}
+	}
+	// Mapped packages come before the relative paths of generated files.
+	sort.SliceStable(stmts, func(i, j int) bool {
+		ri, rj := strings.HasPrefix(stmts[i].path, "."), strings.HasPrefix(stmts[j].path, ".")
+		if ri != rj {
+			return rj
+		}
+		return stmts[i].path < stmts[j].path
+	})
+	result := []string{}
+	modules := []string{}
+	for m := range s.packages {
+		modules = append(modules, m)
+	}
+	sort.Strings(modules)
+	for _, m := range modules {
+		names := append([]string(nil), s.packages[m]...)
+		sort.Strings(names)
+		result = append(result, fmt.Sprintf("import { %s } from \"%s\";", strings.Join(names, ", "), m))
+	}
+	for _, s := range stmts {
+		result = append(result, s.text)
+	}
+	return result, nil
+}
+
+// relativeModulePath returns the module specifier used in the generated file
+// from to import the generated file to. Both are output file names.
+func relativeModulePath(from, to string) string {
+	for _, ext := range []string{".d.ts", ".ts"} {
+		if strings.HasSuffix(to, ext) {
+			to = to[:len(to)-len(ext)]
+			break
+		}
+	}
+	return relativePath(from, to)
+}
+
+// relativePath returns the path of the output file to relative to the
+// directory of the output file from, starting with ./ or ../.
+func relativePath(from, to string) string {
+	fromDir := strings.Split(path.Dir(path.Clean(from)), "/")
+	if fromDir[0] == "." {
+		fromDir = nil
+	}
+	toParts := strings.Split(path.Clean(to), "/")
+	i := 0
+	for i < len(fromDir) && i < len(toParts)-1 && fromDir[i] == toParts[i] {
+		i++
+	}
+	parts := []string{}
+	for range fromDir[i:] {
+		parts = append(parts, "..")
+	}
+	parts = append(parts, toParts[i:]...)
+	rel := strings.Join(parts, "/")
+	if !strings.HasPrefix(rel, "../") {
+		rel = "./" + rel
+	}
+	return rel
+}
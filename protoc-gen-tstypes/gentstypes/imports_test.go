@@ -0,0 +1,54 @@
+package gentstypes
+
+import (
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+func TestRelativeModulePath(t *testing.T) {
+	tests := []struct {
+		from, to, want string
+	}{
+		{"a.ts", "b.ts", "./b"},
+		{"a.ts", "b.d.ts", "./b"},
+		{"x/a.ts", "x/b.ts", "./b"},
+		{"x/a.ts", "b.ts", "../b"},
+		{"a.ts", "x/y/b.ts", "./x/y/b"},
+		{"x/y/a.ts", "x/z/b.ts", "../z/b"},
+		{"./x/a.ts", "x/b.ts", "./b"},
+		{"a.ts", "b.js", "./b.js"},
+	}
+	for _, tt := range tests {
+		if got := relativeModulePath(tt.from, tt.to); got != tt.want {
+			t.Errorf("relativeModulePath(%q, %q) = %q, want %q", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestImportSetAddName(t *testing.T) {
+	files := parseFiles(t, map[string]string{
+		"a.proto": `syntax = "proto3"; package a.b; message M {} message N {}`,
+		"c.proto": `syntax = "proto3"; package c; message M {}`,
+	})
+	fds := map[string]*desc.FileDescriptor{}
+	for _, f := range files {
+		fds[f.GetName()] = f
+	}
+	s := newImportSet(nil)
+	s.reserve("N")
+	tests := []struct {
+		file, name, want string
+	}{
+		{"a.proto", "M", "M"},
+		{"a.proto", "M", "M"},
+		{"c.proto", "M", "c_M"},
+		{"a.proto", "N", "a_b_N"},
+		{"a.proto", "c_M", "a_b_c_M"},
+	}
+	for _, tt := range tests {
+		if got := s.addName(fds[tt.file], tt.name, false); got != tt.want {
+			t.Errorf("addName(%s, %s) = %q, want %q", tt.file, tt.name, got, tt.want)
+		}
+	}
+}
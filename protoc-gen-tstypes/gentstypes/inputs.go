@@ -0,0 +1,133 @@
+package gentstypes
+
+import (
+	"github.com/jhump/protoreflect/desc"
+	"github.com/pkg/errors"
+)
+
+// With Parameters.InputTypes messages have two shapes following
+// google.api.field_behavior. The type named after the message is the
+// response shape, without INPUT_ONLY fields and with OUTPUT_ONLY and
+// IMMUTABLE fields read-only. The input shape, used for requests, has no
+// OUTPUT_ONLY fields and refers to the input shapes of other messages. It is
+// only declared, as <Name>Input, for messages whose shapes differ. Messages
+// with 64 bit integers represented as string_number, or referring to such
+// messages, have an input shape whatever Parameters.InputTypes, accepting
+// numbers too.
+
+const inputSuffix = "Input"
+
+// shapeFields returns the fields of m that are part of the shape being
+// generated.
+func (g *Generator) shapeFields(m *desc.MessageDescriptor, params *Parameters) []*desc.FieldDescriptor {
+	return g.inShape(m.GetFields(), params)
+}
+
+// inShape returns the fields of fields that are part of the shape being
+// generated.
+func (g *Generator) inShape(fields []*desc.FieldDescriptor, params *Parameters) []*desc.FieldDescriptor {
+	if !params.InputTypes {
+		return fields
+	}
+	result := []*desc.FieldDescriptor{}
+	for _, f := range fields {
+		fOpts := fieldOptions(f, params)
+		if g.inputShape && fOpts.OutputOnly || !g.inputShape && fOpts.InputOnly {
+			continue
+		}
+		result = append(result, f)
+	}
+	return result
+}
+
+// isReadOnly reports whether f is a read-only property of the shape being
+// generated.
+func (g *Generator) isReadOnly(f *desc.FieldDescriptor, params *Parameters) bool {
+	if !params.InputTypes || g.inputShape {
+		return false
+	}
+	fOpts := fieldOptions(f, params)
+	return fOpts.OutputOnly || fOpts.Immutable
+}
+
+// needsInput reports whether the input shape of m differs from its response
+// shape, because m or a message it refers to has OUTPUT_ONLY or INPUT_ONLY
+// fields, or string_number 64 bit integers.
+func needsInput(m *desc.MessageDescriptor, params *Parameters) bool {
+	visited := map[*desc.MessageDescriptor]bool{}
+	var visit func(m *desc.MessageDescriptor) bool
+	visit = func(m *desc.MessageDescriptor) bool {
+		if visited[m] {
+			return false
+		}
+		visited[m] = true
+		for _, f := range m.GetFields() {
+			fOpts := fieldOptions(f, params)
+			if params.InputTypes && (fOpts.OutputOnly || fOpts.InputOnly) || isStringNumber(f, params) {
+				return true
+			}
+			if t := f.GetMessageType(); t != nil && !hasJSONRepresentation(t, params) && visit(t) {
+				return true
+			}
+		}
+		return false
+	}
+	return visit(m)
+}
+
+// inputTypeName returns the name the input shape of m is referred to by in
+// the file being generated.
+func (g *Generator) inputTypeName(m *desc.MessageDescriptor, params *Parameters) string {
+	if !needsInput(m, params) {
+		return g.typeName(m, params)
+	}
+	if params.ESModules && !g.files[m.GetFile()] {
+		return g.importName(m, inputSuffix, false, params)
+	}
+	return g.localName(m, inputSuffix, params)
+}
+
+// requestCodecName returns the name of the ToJSON function of the shape of m
+// used for requests.
+func (g *Generator) requestCodecName(m *desc.MessageDescriptor, params *Parameters) string {
+	if needsInput(m, params) {
+		return g.codecName(m, inputSuffix+toJSONSuffix, params)
+	}
+	return g.codecName(m, toJSONSuffix, params)
+}
+
+// reserveInputNames reserves the names of the input shapes and their codecs
+// declared by the output of files.
+func (g *Generator) reserveInputNames(files []*desc.FileDescriptor, params *Parameters) {
+	for _, f := range files {
+		for _, d := range fileTypes(f) {
+			if m, ok := d.(*desc.MessageDescriptor); ok && needsInput(m, params) {
+				g.imports.reserve(packageQualifiedName(m) + inputSuffix)
+				g.imports.reserve(packageQualifiedName(m) + inputSuffix + toJSONSuffix)
+			}
+		}
+	}
+}
+
+// checkInputNameCollisions returns an error if the input shape of a message
+// of files, which share a package, has the name of another type.
+func checkInputNameCollisions(files []*desc.FileDescriptor, params *Parameters) error {
+	types := map[string]desc.Descriptor{}
+	for _, f := range files {
+		for _, d := range fileTypes(f) {
+			types[packageQualifiedName(d)] = d
+		}
+	}
+	for _, f := range files {
+		for _, d := range fileTypes(f) {
+			m, ok := d.(*desc.MessageDescriptor)
+			if !ok || !needsInput(m, params) {
+				continue
+			}
+			if other, ok := types[packageQualifiedName(m)+inputSuffix]; ok {
+				return errors.Errorf("%s: the input type of %s has the name of %s", f.GetName(), m.GetFullyQualifiedName(), other.GetFullyQualifiedName())
+			}
+		}
+	}
+	return nil
+}
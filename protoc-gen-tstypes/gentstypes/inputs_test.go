@@ -0,0 +1,98 @@
+package gentstypes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+const fieldBehaviorHeader = `syntax = "proto3"; package i; import "google/api/field_behavior.proto"; import "opts/opts.proto"; `
+
+func TestNeedsInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		params Parameters
+		want   bool
+	}{
+		{"no behavior", `message M { string a = 1; }`, Parameters{InputTypes: true}, false},
+		{"output only", `message M { string a = 1 [(google.api.field_behavior) = OUTPUT_ONLY]; }`, Parameters{InputTypes: true}, true},
+		{"input only", `message M { string a = 1 [(google.api.field_behavior) = INPUT_ONLY]; }`, Parameters{InputTypes: true}, true},
+		{"immutable", `message M { string a = 1 [(google.api.field_behavior) = IMMUTABLE]; }`, Parameters{InputTypes: true}, false},
+		{"without input_types", `message M { string a = 1 [(google.api.field_behavior) = OUTPUT_ONLY]; }`, Parameters{}, false},
+		{"referred message", `message M { N n = 1; } message N { string a = 1 [(google.api.field_behavior) = OUTPUT_ONLY]; }`, Parameters{InputTypes: true}, true},
+		{"recursive message", `message M { M m = 1; N n = 2; } message N { string a = 1; }`, Parameters{InputTypes: true}, false},
+		{"string_number", `message M { int64 a = 1; }`, Parameters{Int64: Int64StringNumber}, true},
+		{"string_number field option", `message M { int64 a = 1 [(opts.field).int64 = INT64_STRING_NUMBER]; }`, Parameters{}, true},
+		{"string", `message M { int64 a = 1; }`, Parameters{Int64: Int64String}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := parseFiles(t, map[string]string{"i.proto": fieldBehaviorHeader + tt.source})
+			if got := needsInput(files[0].FindMessage("i.M"), &tt.params); got != tt.want {
+				t.Errorf("needsInput = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInShape(t *testing.T) {
+	const source = `message M {
+		string name = 1 [(google.api.field_behavior) = REQUIRED];
+		string id = 2 [(google.api.field_behavior) = OUTPUT_ONLY];
+		string password = 3 [(google.api.field_behavior) = INPUT_ONLY];
+		string parent = 4 [(google.api.field_behavior) = IMMUTABLE];
+	}`
+	tests := []struct {
+		name       string
+		inputTypes bool
+		inputShape bool
+		fields     []string
+		readOnly   []string
+	}{
+		{"response", true, false, []string{"name", "id", "parent"}, []string{"id", "parent"}},
+		{"input", true, true, []string{"name", "password", "parent"}, []string{}},
+		{"without input_types", false, false, []string{"name", "id", "password", "parent"}, []string{}},
+	}
+	files := parseFiles(t, map[string]string{"i.proto": fieldBehaviorHeader + source})
+	m := files[0].FindMessage("i.M")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := New()
+			g.inputShape = tt.inputShape
+			params := &Parameters{InputTypes: tt.inputTypes}
+			fields, readOnly := []string{}, []string{}
+			for _, f := range g.shapeFields(m, params) {
+				fields = append(fields, f.GetName())
+				if g.isReadOnly(f, params) {
+					readOnly = append(readOnly, f.GetName())
+				}
+			}
+			if !reflect.DeepEqual(fields, tt.fields) || !reflect.DeepEqual(readOnly, tt.readOnly) {
+				t.Errorf("got fields %v, read-only %v, want %v, %v", fields, readOnly, tt.fields, tt.readOnly)
+			}
+		})
+	}
+}
+
+func TestCheckInputNameCollisions(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		err    string
+	}{
+		{"distinct", `message M { string a = 1 [(google.api.field_behavior) = OUTPUT_ONLY]; } message N {}`, ""},
+		{"same name", `message M { string a = 1 [(google.api.field_behavior) = OUTPUT_ONLY]; } message MInput {}`,
+			"i.proto: the input type of i.M has the name of i.MInput"},
+		{"same name without input shape", `message M { string a = 1; } message MInput {}`, ""},
+		{"nested", `message M { message N { string a = 1 [(google.api.field_behavior) = INPUT_ONLY]; } } enum M_NInput { X = 0; }`,
+			"i.proto: the input type of i.M.N has the name of i.M_NInput"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := parseFiles(t, map[string]string{"i.proto": fieldBehaviorHeader + tt.source})
+			checkError(t, checkInputNameCollisions([]*desc.FileDescriptor{files[0]}, &Parameters{InputTypes: true}), tt.err)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package gentstypes
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/pkg/errors"
+	"github.com/tmc/grpcutil/protoc-gen-tstypes/opts"
+)
+
+// Int64Representation selects the TypeScript type of 64 bit integer fields,
+// signed, unsigned and fixed alike. The JSON encoding always uses strings.
+type Int64Representation string
+
+const (
+	Int64Number Int64Representation = "number"
+	Int64String Int64Representation = "string"
+	// Int64BigInt is decoded to a bigint by the JSON codecs, without them the
+	// JSON value is a string or a number.
+	Int64BigInt Int64Representation = "bigint"
+	// Int64StringNumber is a string in responses and decoded messages, and a
+	// string or a number in the input shapes of requests. The messages using
+	// it have an input shape.
+	Int64StringNumber Int64Representation = "string_number"
+)
+
+// ParseInt64Representation parses the name of an Int64Representation.
+func ParseInt64Representation(s string) (Int64Representation, error) {
+	switch r := Int64Representation(s); r {
+	case Int64Number, Int64String, Int64BigInt, Int64StringNumber:
+		return r, nil
+	}
+	return "", errors.Errorf("unknown int64 representation %q, expected one of number, string, bigint or string_number", s)
+}
+
+// tsType returns the TypeScript type of the representation, in the input
+// shape of requests if input is set.
+func (r Int64Representation) tsType(input bool) string {
+	switch {
+	case r == Int64StringNumber && input:
+		return "string | number"
+	case r == Int64StringNumber:
+		return "string"
+	}
+	return string(r)
+}
+
+// isStringNumber reports whether the 64 bit integer field f, or the 64 bit
+// integer wrapped by f, is represented as Int64StringNumber.
+func isStringNumber(f *desc.FieldDescriptor, params *Parameters) bool {
+	if t := f.GetMessageType(); t != nil {
+		if !params.WellKnownTypesAsJSON || !isWrapperType(t) || !isInt64(t.FindFieldByName("value")) {
+			return false
+		}
+	} else if !isInt64(f) {
+		return false
+	}
+	return int64Representation(f, params) == Int64StringNumber
+}
+
+// fromJSON returns an expression decoding the JSON value v.
+func (r Int64Representation) fromJSON(v string) string {
+	switch r {
+	case Int64Number:
+		return fmt.Sprintf("Number(%s)", v)
+	case Int64BigInt:
+		return fmt.Sprintf("BigInt(%s)", v)
+	}
+	return fmt.Sprintf("String(%s)", v)
+}
+
+var int64Representations = map[opts.Int64Representation]Int64Representation{
+	opts.Int64Representation_INT64_STRING:        Int64String,
+	opts.Int64Representation_INT64_NUMBER:        Int64Number,
+	opts.Int64Representation_INT64_BIGINT:        Int64BigInt,
+	opts.Int64Representation_INT64_STRING_NUMBER: Int64StringNumber,
+}
+
+func isInt64(f *desc.FieldDescriptor) bool {
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return true
+	}
+	return false
+}
+
+// int64Representation returns the representation of the 64 bit integer field
+// f, or of the 64 bit integers wrapped by f. Field options take precedence
+// over the message defaults and the parameters. The keys and values of a map
+// use the options of the map field. Without the JSON codecs nothing converts
+// bigints, options selecting them fall back to the string sent in JSON.
+func int64Representation(f *desc.FieldDescriptor, params *Parameters) Int64Representation {
+	if m := f.GetOwner(); m.IsMapEntry() {
+		if p, ok := m.GetParent().(*desc.MessageDescriptor); ok {
+			for _, mf := range p.GetFields() {
+				if mf.GetMessageType() == m {
+					f = mf
+				}
+			}
+		}
+	}
+	if r := fieldOptions(f, params).Int64; r != "" {
+		if r == Int64BigInt && !params.JSONCodecs {
+			return Int64String
+		}
+		return r
+	}
+	if params.Int64 != "" {
+		return params.Int64
+	}
+	if params.Int64AsString {
+		return Int64String
+	}
+	return Int64Number
+}
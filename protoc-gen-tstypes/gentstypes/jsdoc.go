@@ -0,0 +1,106 @@
+package gentstypes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+// wdoc writes the documentation of the element d. With JSDoc enabled it is a
+// /** */ block holding the detached, leading and trailing comments of d,
+// @deprecated if d is deprecated and, for fields, the field number and
+// original name. Otherwise the leading comments are written as // comments.
+func (g *Generator) wdoc(d desc.Descriptor, params *Parameters) {
+	info := d.GetSourceInfo()
+	if !params.JSDoc {
+		g.wcomment(info.GetLeadingComments())
+		return
+	}
+	lines := []string{}
+	paragraph := func(s string) {
+		s = strings.Trim(s, "\n")
+		if strings.TrimSpace(s) == "" {
+			return
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		for _, l := range strings.Split(s, "\n") {
+			lines = append(lines, strings.TrimRight(strings.TrimPrefix(l, " "), " "))
+		}
+	}
+	for _, c := range info.GetLeadingDetachedComments() {
+		paragraph(c)
+	}
+	paragraph(info.GetLeadingComments())
+	paragraph(info.GetTrailingComments())
+	tags := []string{}
+	if isDeprecated(d) {
+		tags = append(tags, "@deprecated")
+	}
+	if f, ok := d.(*desc.FieldDescriptor); ok {
+		tags = append(tags, fmt.Sprintf("@fieldNumber %d", f.GetNumber()), "@protoName "+f.GetName())
+	}
+	if len(lines) > 0 && len(tags) > 0 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, tags...)
+	for i, l := range lines {
+		// The comments must not end the block.
+		lines[i] = strings.Replace(l, "*/", "*\\/", -1)
+	}
+	switch len(lines) {
+	case 0:
+		return
+	case 1:
+		g.W("/** " + lines[0] + " */")
+		return
+	}
+	g.W("/**")
+	for _, l := range lines {
+		if l == "" {
+			g.W(" *")
+			continue
+		}
+		g.W(" * " + l)
+	}
+	g.W(" */")
+}
+
+// isDeprecated reports whether the deprecated option of d is set.
+func isDeprecated(d desc.Descriptor) bool {
+	switch d := d.(type) {
+	case *desc.MessageDescriptor:
+		return d.GetMessageOptions().GetDeprecated()
+	case *desc.FieldDescriptor:
+		return d.GetFieldOptions().GetDeprecated()
+	case *desc.EnumDescriptor:
+		return d.GetEnumOptions().GetDeprecated()
+	case *desc.EnumValueDescriptor:
+		return d.GetEnumValueOptions().GetDeprecated()
+	case *desc.ServiceDescriptor:
+		return d.GetServiceOptions().GetDeprecated()
+	case *desc.MethodDescriptor:
+		return d.GetMethodOptions().GetDeprecated()
+	}
+	return false
+}
+
+// hasDoc reports whether wdoc writes anything for d.
+func hasDoc(d desc.Descriptor, params *Parameters) bool {
+	info := d.GetSourceInfo()
+	if !params.JSDoc {
+		return info.GetLeadingComments() != ""
+	}
+	if _, ok := d.(*desc.FieldDescriptor); ok || isDeprecated(d) {
+		return true
+	}
+	comments := append([]string{info.GetLeadingComments(), info.GetTrailingComments()}, info.GetLeadingDetachedComments()...)
+	for _, c := range comments {
+		if strings.TrimSpace(c) != "" {
+			return true
+		}
+	}
+	return false
+}
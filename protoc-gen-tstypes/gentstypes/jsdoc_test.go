@@ -0,0 +1,46 @@
+package gentstypes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+func TestWdoc(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		jsdoc  bool
+		want   string
+	}{
+		{"no comment", `message M {}`, true, ""},
+		{"leading", "// A message.\nmessage M {}", true, "/** A message. */"},
+		{"leading without jsdoc", "// A message.\nmessage M {}", false, "// A message."},
+		{"paragraphs", "// Detached.\n\n// A message.\n// Second line.\nmessage M {}", true,
+			"/**\n * Detached.\n *\n * A message.\n * Second line.\n */"},
+		{"end of comment", "// Matches a/*/b.\nmessage M {}", true, "/** Matches a/*\\/b. */"},
+		{"deprecated", "// Old.\nmessage M { option deprecated = true; }", true, "/**\n * Old.\n *\n * @deprecated\n */"},
+		{"deprecated without comment", `message M { option deprecated = true; }`, true, "/** @deprecated */"},
+		{"field", "message M {\n  // The x.\n  int32 x_y = 3; // Trailing.\n}", true,
+			"/**\n * The x.\n *\n * Trailing.\n *\n * @fieldNumber 3\n * @protoName x_y\n */"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := parseFiles(t, map[string]string{"d.proto": "syntax = \"proto3\";\npackage d;\n\n" + tt.source})
+			var d desc.Descriptor = files[0].FindMessage("d.M")
+			if fields := files[0].FindMessage("d.M").GetFields(); len(fields) > 0 {
+				d = fields[0]
+			}
+			g := New()
+			params := &Parameters{JSDoc: tt.jsdoc}
+			g.wdoc(d, params)
+			if got := strings.TrimSuffix(g.String(), "\n"); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+			if got := hasDoc(d, params); got != (tt.want != "") {
+				t.Errorf("hasDoc = %v, want %v", got, tt.want != "")
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package gentstypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// The JSON Schema output describes the same JSON documents as the generated
+// TypeScript types: field names, required fields, enums and 64 bit integers
+// follow the same parameters and options. Each output becomes a schema with
+// its messages and enums in $defs, named like the types. In a bundle the
+// names are qualified with their package.
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// schema is a JSON object that keeps the order of its members.
+type schema []schemaMember
+
+type schemaMember struct {
+	key   string
+	value interface{}
+}
+
+func (s schema) MarshalJSON() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteString("{")
+	for i, m := range s {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		k, err := json.Marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteString(":")
+		buf.Write(v)
+	}
+	buf.WriteString("}")
+	return buf.Bytes(), nil
+}
+
+// jsonSchemaName returns the name of the schema generated next to the
+// TypeScript file name.
+func jsonSchemaName(name string) string {
+	for _, ext := range []string{".d.ts", ".ts"} {
+		if strings.HasSuffix(name, ext) {
+			return name[:len(name)-len(ext)] + ".schema.json"
+		}
+	}
+	return strings.TrimSuffix(name, path.Ext(name)) + ".schema.json"
+}
+
+// generateJSONSchema returns the schema file of files, name is the name of
+// the TypeScript file generated for them.
+func (g *Generator) generateJSONSchema(files []*desc.FileDescriptor, name string, params *Parameters) (*plugin.CodeGeneratorResponse_File, error) {
+	schemaName := jsonSchemaName(name)
+	defs := schema{}
+	types := []desc.Descriptor{}
+	for _, f := range files {
+		types = append(types, fileTypes(f)...)
+	}
+	for _, d := range types {
+		var def schema
+		switch d := d.(type) {
+		case *desc.EnumDescriptor:
+			def = enumSchema(d, params)
+		case *desc.MessageDescriptor:
+			// Maps are objects, their entries need no definition.
+			if d.IsMapEntry() {
+				continue
+			}
+			var err error
+			if def, err = g.messageSchema(d, schemaName, params); err != nil {
+				return nil, err
+			}
+		}
+		defs = append(defs, schemaMember{schemaDefName(d, params), def})
+	}
+	title := name
+	if params.Bundle == "" {
+		title = files[0].GetName()
+	}
+	s := schema{
+		{"$schema", jsonSchemaDialect},
+		{"$id", path.Clean(schemaName)},
+		{"title", title},
+		{"$defs", defs},
+	}
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(s); err != nil {
+		return nil, err
+	}
+	return &plugin.CodeGeneratorResponse_File{
+		Name:    proto.String(schemaName),
+		Content: proto.String(buf.String()),
+	}, nil
+}
+
+// withDescription adds the leading comments of the element described by info
+// to s.
+func withDescription(s schema, info *descriptor.SourceCodeInfo_Location) schema {
+	if c := strings.TrimSpace(info.GetLeadingComments()); c != "" {
+		s = append(schema{{"description", c}}, s...)
+	}
+	return s
+}
+
+func enumSchema(e *desc.EnumDescriptor, params *Parameters) schema {
+	values := []interface{}{}
+	for _, v := range enumValues(e, params) {
+		if params.EnumsAsInt {
+			values = append(values, v.GetNumber())
+		} else {
+			values = append(values, v.GetName())
+		}
+	}
+	t := "string"
+	if params.EnumsAsInt {
+		t = "integer"
+	}
+	return withDescription(schema{{"type", t}, {"enum", values}}, e.GetSourceInfo())
+}
+
+func (g *Generator) messageSchema(m *desc.MessageDescriptor, schemaName string, params *Parameters) (schema, error) {
+	properties := schema{}
+	required := []string{}
+	for _, f := range m.GetFields() {
+		fs, err := g.fieldSchema(f, schemaName, params)
+		if err != nil {
+			return nil, err
+		}
+		name := fieldName(f, params)
+		properties = append(properties, schemaMember{name, withDescription(fs, f.GetSourceInfo())})
+		// Oneof members are never required, at most one of them is set.
+		if f.GetOneOf() == nil && isRequired(f, fieldOptions(f, params), params) {
+			required = append(required, name)
+		}
+	}
+	s := schema{{"type", "object"}, {"properties", properties}}
+	if len(required) > 0 {
+		s = append(s, schemaMember{"required", required})
+	}
+	return withDescription(s, m.GetSourceInfo()), nil
+}
+
+func (g *Generator) fieldSchema(f *desc.FieldDescriptor, schemaName string, params *Parameters) (schema, error) {
+	if f.IsMap() {
+		v, err := g.valueSchema(f.GetMapValueType(), schemaName, params)
+		if err != nil {
+			return nil, err
+		}
+		return schema{{"type", "object"}, {"additionalProperties", v}}, nil
+	}
+	v, err := g.valueSchema(f, schemaName, params)
+	if err != nil {
+		return nil, err
+	}
+	if f.IsRepeated() {
+		return schema{{"type", "array"}, {"items", v}}, nil
+	}
+	return v, nil
+}
+
+// valueSchema returns the schema of a single value of f.
+func (g *Generator) valueSchema(f *desc.FieldDescriptor, schemaName string, params *Parameters) (schema, error) {
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE, descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return schema{{"type", "number"}}, nil
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return schema{{"type", "boolean"}}, nil
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return schema{{"type", "string"}}, nil
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return schema{{"type", "string"}, {"contentEncoding", "base64"}}, nil
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return g.schemaRef(f.GetEnumType(), schemaName, params)
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		t := f.GetMessageType()
+		if params.WellKnownTypesAsJSON {
+			if isWrapperType(t) {
+				v := t.FindFieldByName("value")
+				inner, err := g.valueSchema(v, schemaName, params)
+				if err != nil {
+					return nil, err
+				}
+				if isInt64(v) {
+					// The options of f apply to the wrapped value.
+					inner = int64Schema(v, int64Representation(f, params))
+				}
+				return schema{{"anyOf", []schema{inner, {{"type", "null"}}}}}, nil
+			}
+			if s := wellKnownTypeSchema(t); s != nil {
+				return s, nil
+			}
+		}
+		return g.schemaRef(t, schemaName, params)
+	}
+	if isInt64(f) {
+		return int64Schema(f, int64Representation(f, params)), nil
+	}
+	return schema{{"type", "integer"}}, nil
+}
+
+// int64Schema returns the schema of a 64 bit integer of field f. Strings are
+// accepted for every representation but number.
+func int64Schema(f *desc.FieldDescriptor, r Int64Representation) schema {
+	pattern := "^-?[0-9]+$"
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		pattern = "^[0-9]+$"
+	}
+	switch r {
+	case Int64Number:
+		return schema{{"type", "integer"}}
+	case Int64String:
+		return schema{{"type", "string"}, {"pattern", pattern}}
+	}
+	return schema{{"type", []string{"string", "integer"}}, {"pattern", pattern}}
+}
+
+// wellKnownTypeSchema returns the schema of the canonical JSON representation
+// of the well-known type m, or nil if m has no special representation. It
+// matches wellKnownType.
+func wellKnownTypeSchema(m *desc.MessageDescriptor) schema {
+	switch m.GetFullyQualifiedName() {
+	case "google.protobuf.Timestamp":
+		return schema{{"type", "string"}, {"format", "date-time"}}
+	case "google.protobuf.Duration":
+		return schema{{"type", "string"}, {"pattern", `^-?[0-9]+(\.[0-9]{0,9})?s$`}}
+	case "google.protobuf.FieldMask":
+		return schema{{"type", "string"}}
+	case "google.protobuf.Struct":
+		return schema{{"type", "object"}}
+	case "google.protobuf.Value":
+		return schema{}
+	case "google.protobuf.ListValue":
+		return schema{{"type", "array"}}
+	case "google.protobuf.Any":
+		return schema{
+			{"type", "object"},
+			{"properties", schema{{"@type", schema{{"type", "string"}}}}},
+			{"required", []string{"@type"}},
+		}
+	case "google.protobuf.Empty":
+		return schema{{"type", "object"}, {"maxProperties", 0}}
+	}
+	return nil
+}
+
+// schemaDefName returns the name of the definition of the message or enum d.
+func schemaDefName(d desc.Descriptor, params *Parameters) string {
+	if pkg := d.GetFile().GetPackage(); params.Bundle != "" && pkg != "" {
+		return pkg + "." + qualifiedName(d, params)
+	}
+	return qualifiedName(d, params)
+}
+
+// schemaRef returns a reference to the definition of the message or enum d,
+// which is in the schema generated for the output d is declared in. Files
+// outside of the output are expected to be generated one by one.
+func (g *Generator) schemaRef(d desc.Descriptor, schemaName string, params *Parameters) (schema, error) {
+	if g.files[d.GetFile()] {
+		return schema{{"$ref", "#/$defs/" + schemaDefName(d, params)}}, nil
+	}
+	name, err := genName(g.Request, d.GetFile(), params.OutputNamePattern)
+	if err != nil {
+		return nil, err
+	}
+	ref := relativePath(schemaName, jsonSchemaName(name)) + "#/$defs/" + qualifiedName(d, params)
+	return schema{{"$ref", ref}}, nil
+}
@@ -0,0 +1,61 @@
+package gentstypes
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONSchemaName(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"a.d.ts", "a.schema.json"},
+		{"x/a.b.ts", "x/a.b.schema.json"},
+		{"a.js", "a.schema.json"},
+		{"a", "a.schema.json"},
+	}
+	for _, tt := range tests {
+		if got := jsonSchemaName(tt.in); got != tt.want {
+			t.Errorf("jsonSchemaName(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestValueSchema(t *testing.T) {
+	const header = `syntax = "proto3"; package s; import "opts/opts.proto"; import "google/protobuf/timestamp.proto"; import "google/protobuf/wrappers.proto"; `
+	tests := []struct {
+		name   string
+		field  string
+		params Parameters
+		want   string
+	}{
+		{"bytes", `bytes x = 1;`, Parameters{}, `{"type":"string","contentEncoding":"base64"}`},
+		{"int32", `int32 x = 1;`, Parameters{}, `{"type":"integer"}`},
+		{"int64", `int64 x = 1;`, Parameters{}, `{"type":"integer"}`},
+		{"int64 string", `int64 x = 1;`, Parameters{Int64: Int64String}, `{"type":"string","pattern":"^-?[0-9]+$"}`},
+		{"uint64 string", `uint64 x = 1;`, Parameters{Int64: Int64String}, `{"type":"string","pattern":"^[0-9]+$"}`},
+		{"int64 bigint", `int64 x = 1;`, Parameters{Int64: Int64BigInt}, `{"type":["string","integer"],"pattern":"^-?[0-9]+$"}`},
+		{"int64 field option", `int64 x = 1 [(opts.field).int64 = INT64_STRING];`, Parameters{}, `{"type":"string","pattern":"^-?[0-9]+$"}`},
+		{"timestamp", `google.protobuf.Timestamp x = 1;`, Parameters{WellKnownTypesAsJSON: true}, `{"type":"string","format":"date-time"}`},
+		{"wrapper", `google.protobuf.StringValue x = 1;`, Parameters{WellKnownTypesAsJSON: true}, `{"anyOf":[{"type":"string"},{"type":"null"}]}`},
+		{"int64 wrapper", `google.protobuf.Int64Value x = 1 [(opts.field).int64 = INT64_STRING];`, Parameters{WellKnownTypesAsJSON: true},
+			`{"anyOf":[{"type":"string","pattern":"^-?[0-9]+$"},{"type":"null"}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := parseFiles(t, map[string]string{"s.proto": header + "message M { " + tt.field + " }"})
+			f := files[0].FindMessage("s.M").FindFieldByName("x")
+			s, err := New().valueSchema(f, "s.schema.json", &tt.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := json.Marshal(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package gentstypes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+// The server handler interfaces declare the methods a Node gRPC server
+// implements for a service, whatever the library serving it. Each handler is
+// given the request and a ServerCallContext, streams are async iterables in
+// both directions.
+
+const serverSuffix = "Server"
+
+// serverHelpers holds the declarations used by the generated handler
+// interfaces, they are only written to files that use them. They are
+// exported, implementations refer to them.
+var serverHelpers = map[string]string{
+	"ServerCallContext": `export interface ServerCallContext {
+    // method is the full method name, e.g. /routeguide.RouteGuide/GetFeature.
+    method: string;
+    // metadata holds the request headers, names are in lower case.
+    metadata: { [key: string]: Array<string> };
+    // deadline is set if the client sent a timeout.
+    deadline?: Date;
+    // signal is aborted when the call is cancelled or its deadline passes.
+    signal: AbortSignal;
+    // setHeader and setTrailer add response headers and trailers, headers
+    // are sent with the first response.
+    setHeader(name: string, value: string): void;
+    setTrailer(name: string, value: string): void;
+}`,
+}
+
+// reserveServerNames reserves the names of the handler interfaces and helpers
+// declared by the file being generated.
+func (g *Generator) reserveServerNames(files []*desc.FileDescriptor) {
+	for _, f := range files {
+		for _, svc := range f.GetServices() {
+			g.imports.reserve(svc.GetName() + serverSuffix)
+		}
+	}
+	for n := range serverHelpers {
+		g.imports.reserve(n)
+	}
+}
+
+func (g *Generator) generateServerHandlers(service *desc.ServiceDescriptor, params *Parameters) {
+	ctx := g.helper("ServerCallContext")
+	if !strings.HasSuffix(g.String(), "\n\n") {
+		g.W("")
+	}
+	g.wdoc(service, params)
+	g.W(fmt.Sprintf("export interface %s {", service.GetName()+serverSuffix))
+	for _, m := range service.GetMethods() {
+		// Requests are received in their input shape.
+		in := g.inputTypeName(m.GetInputType(), params)
+		out := g.typeName(m.GetOutputType(), params)
+		if m.IsClientStreaming() {
+			in = fmt.Sprintf("AsyncIterable<%s>", in)
+		}
+		if m.IsServerStreaming() {
+			out = fmt.Sprintf("AsyncIterable<%s>", out)
+		} else {
+			out = fmt.Sprintf("Promise<%s>", out)
+		}
+		g.incIndent()
+		g.wdoc(m, params)
+		g.W(fmt.Sprintf("%s(r: %s, ctx: %s): %s;", m.GetName(), in, ctx, out))
+		g.decIndent()
+	}
+	g.W("}\n")
+}
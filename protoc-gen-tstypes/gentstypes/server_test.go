@@ -0,0 +1,38 @@
+package gentstypes
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestServerHandlers(t *testing.T) {
+	const header = `syntax = "proto3"; package h; import "google/api/field_behavior.proto"; message M {} message R { string id = 1 [(google.api.field_behavior) = OUTPUT_ONLY]; } `
+	tests := []struct {
+		name       string
+		method     string
+		inputTypes bool
+		want       string
+	}{
+		{"unary", `rpc Get(M) returns (M);`, false, "Get(r: M, ctx: ServerCallContext): Promise<M>;"},
+		{"server streaming", `rpc Watch(M) returns (stream M);`, false, "Watch(r: M, ctx: ServerCallContext): AsyncIterable<M>;"},
+		{"client streaming", `rpc Upload(stream M) returns (M);`, false, "Upload(r: AsyncIterable<M>, ctx: ServerCallContext): Promise<M>;"},
+		{"bidirectional streaming", `rpc Chat(stream M) returns (stream M);`, false, "Chat(r: AsyncIterable<M>, ctx: ServerCallContext): AsyncIterable<M>;"},
+		{"input shape", `rpc Create(R) returns (R);`, true, "Create(r: RInput, ctx: ServerCallContext): Promise<R>;"},
+		{"input shape without input_types", `rpc Create(R) returns (R);`, false, "Create(r: R, ctx: ServerCallContext): Promise<R>;"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &Parameters{ESModules: true, ServerHandlers: true, InputTypes: tt.inputTypes, OutputNamePattern: "{{.BaseName}}.ts"}
+			files, err := generate(t, map[string]string{"h.proto": header + "service S { " + tt.method + " }"}, params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := files["h.ts"]
+			for _, w := range []string{"export interface SServer {\n    " + tt.want + "\n}", "export interface ServerCallContext {"} {
+				if !strings.Contains(got, w) {
+					t.Errorf("output does not contain %q:\n%s", w, got)
+				}
+			}
+		})
+	}
+}
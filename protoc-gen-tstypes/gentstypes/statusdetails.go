@@ -0,0 +1,161 @@
+package gentstypes
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/pkg/errors"
+	// Registers google/rpc/error_details.proto for files that do not import
+	// it.
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// With Parameters.StatusDetails the outputs declaring services also declare
+// the types of the google.rpc.Status details their calls may fail with: the
+// messages of google/rpc/error_details.proto and the messages registered in
+// Parameters.StatusDetailTypes, keyed by type URL. The files declaring them
+// are generated along with the requested files unless they are mapped to a
+// module.
+
+const (
+	errorDetailsFile = "google/rpc/error_details.proto"
+	typeURLPrefix    = "type.googleapis.com/"
+)
+
+// statusDetailNames are the names declared for the status details.
+var statusDetailNames = []string{"StatusDetailTypes", "StatusDetail", "isStatusDetail", "findStatusDetail", "statusDetailFromJSON", "isPackedStatusDetail"}
+
+// statusDetailTypes returns the messages of the status details, those of
+// google/rpc/error_details.proto and the registered ones. files are the files
+// of the request, error_details.proto is loaded from the linked descriptors if
+// the request does not hold it. It returns an error if the request does not
+// declare a registered message.
+func statusDetailTypes(files map[string]*desc.FileDescriptor, params *Parameters) ([]*desc.MessageDescriptor, error) {
+	errorDetails, ok := files[errorDetailsFile]
+	if !ok {
+		var err error
+		if errorDetails, err = desc.LoadFileDescriptor(errorDetailsFile); err != nil {
+			return nil, errors.Wrap(err, "loading status details")
+		}
+	}
+	result := append([]*desc.MessageDescriptor{}, errorDetails.GetMessageTypes()...)
+	names := []string{}
+	for n := range files {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, t := range params.StatusDetailTypes {
+		var m *desc.MessageDescriptor
+		for _, n := range names {
+			if m = files[n].FindMessage(t); m != nil {
+				break
+			}
+		}
+		if m == nil {
+			return nil, errors.Errorf("status_detail %s: message not found in the request", t)
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+// statusDetailFiles returns the files declaring details that are neither
+// generated nor mapped to a module, sorted by name.
+func statusDetailFiles(generated []*desc.FileDescriptor, details []*desc.MessageDescriptor, params *Parameters) []*desc.FileDescriptor {
+	seen := map[string]bool{}
+	for _, f := range generated {
+		seen[f.GetName()] = true
+	}
+	result := []*desc.FileDescriptor{}
+	for _, m := range details {
+		f := m.GetFile()
+		if _, ok := params.ImportMap[f.GetName()]; ok || seen[f.GetName()] {
+			continue
+		}
+		seen[f.GetName()] = true
+		result = append(result, f)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].GetName() < result[j].GetName() })
+	return result
+}
+
+// reserveStatusDetailNames reserves the names declared for the status
+// details.
+func (g *Generator) reserveStatusDetailNames() {
+	for _, n := range statusDetailNames {
+		g.imports.reserve(n)
+	}
+}
+
+// hasServices reports whether one of files declares a service.
+func hasServices(files []*desc.FileDescriptor) bool {
+	for _, f := range files {
+		if len(f.GetServices()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// topLevelName returns the name the message or enum d, or its codec function
+// if suffix is set, is referred to by outside of the package namespaces of the
+// output being generated.
+func (g *Generator) topLevelName(d desc.Descriptor, suffix string, params *Parameters) string {
+	if !g.files[d.GetFile()] {
+		return g.importName(d, suffix, suffix != "", params)
+	}
+	if pkg := d.GetFile().GetPackage(); params.DeclareNamespace && pkg != "" && (!params.ESModules || params.Bundle != "") {
+		return pkg + "." + qualifiedName(d, params) + suffix
+	}
+	return qualifiedName(d, params) + suffix
+}
+
+func (g *Generator) generateStatusDetails(params *Parameters) {
+	g.W("// StatusDetailTypes maps the type URLs of the known google.rpc.Status")
+	g.W("// details to their types.")
+	g.W("export interface StatusDetailTypes {")
+	for _, m := range g.statusDetails {
+		g.W(indent + fmt.Sprintf("%q: %s;", typeURLPrefix+m.GetFullyQualifiedName(), g.topLevelName(m, "", params)))
+	}
+	g.W("}\n")
+	g.W("// StatusDetail is a google.rpc.Status detail of a known type, narrowed by")
+	g.W("// its \"@type\".")
+	g.W("export type StatusDetail = { [K in keyof StatusDetailTypes]: { \"@type\": K } & StatusDetailTypes[K] }[keyof StatusDetailTypes];\n")
+	g.W("// isPackedStatusDetail reports whether the detail d holds its message in")
+	g.W("// the binary format, in base64, as the details of gRPC-Web trailers do.")
+	g.W("function isPackedStatusDetail(d: { \"@type\": string }): boolean {")
+	g.W(indent + "return Object.keys(d).length === 2 && typeof (d as { value?: unknown }).value === \"string\";")
+	g.W("}\n")
+	g.W("// isStatusDetail reports whether the detail d has the type URL t. Packed")
+	g.W("// details never match.")
+	g.W("export function isStatusDetail<K extends keyof StatusDetailTypes>(d: { \"@type\": string }, t: K): d is { \"@type\": K } & StatusDetailTypes[K] {")
+	g.W(indent + "return d[\"@type\"] === t && !isPackedStatusDetail(d);")
+	g.W("}\n")
+	g.W("// findStatusDetail returns the first of details with the type URL t.")
+	g.W("export function findStatusDetail<K extends keyof StatusDetailTypes>(details: Array<{ \"@type\": string }>, t: K): ({ \"@type\": K } & StatusDetailTypes[K]) | undefined {")
+	g.W(indent + "for (const d of details) {")
+	g.W(indent + indent + "if (isStatusDetail(d, t)) {")
+	g.W(indent + indent + indent + "return d;")
+	g.W(indent + indent + "}")
+	g.W(indent + "}")
+	g.W(indent + "return undefined;")
+	g.W("}\n")
+	if !params.JSONCodecs {
+		return
+	}
+	g.W("// statusDetailFromJSON decodes the detail d with the JSON codecs if its type")
+	g.W("// is known. Packed details are returned as is.")
+	g.W("export function statusDetailFromJSON(d: { \"@type\": string }): StatusDetail | { \"@type\": string } {")
+	g.W(indent + "if (isPackedStatusDetail(d)) {")
+	g.W(indent + indent + "return d;")
+	g.W(indent + "}")
+	g.W(indent + "switch (d[\"@type\"]) {")
+	for _, m := range g.statusDetails {
+		g.W(indent + fmt.Sprintf("case %q:", typeURLPrefix+m.GetFullyQualifiedName()))
+		g.W(indent + indent + fmt.Sprintf("return { \"@type\": d[\"@type\"], ...%s(d) };", g.topLevelName(m, fromJSONSuffix, params)))
+	}
+	g.W(indent + "}")
+	g.W(indent + "return d;")
+	g.W("}\n")
+}
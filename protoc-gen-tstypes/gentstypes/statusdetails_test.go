@@ -0,0 +1,66 @@
+package gentstypes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+func TestStatusDetailsPacked(t *testing.T) {
+	const source = `syntax = "proto3"; package s; message M {} service S { rpc Get(M) returns (M); }`
+	tests := []struct {
+		name   string
+		params Parameters
+		want   []string
+	}{
+		{"narrowing", Parameters{ESModules: true, WellKnownTypesAsJSON: true, StatusDetails: true},
+			[]string{`return d["@type"] === t && !isPackedStatusDetail(d);`}},
+		{"decoding", Parameters{ESModules: true, WellKnownTypesAsJSON: true, JSONCodecs: true, StatusDetails: true},
+			[]string{`return d["@type"] === t && !isPackedStatusDetail(d);`, "    if (isPackedStatusDetail(d)) {\n        return d;\n    }\n    switch"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			params.OutputNamePattern = "{{.BaseName}}.ts"
+			files, err := generate(t, map[string]string{"s.proto": source}, &params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := files["s.ts"]
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("generated output does not contain %q:\n%s", w, got)
+				}
+			}
+		})
+	}
+}
+
+func TestStatusDetailTypes(t *testing.T) {
+	tests := []struct {
+		name  string
+		types []string
+		want  string
+		err   string
+	}{
+		{"error details", nil, "google.rpc.LocalizedMessage", ""},
+		{"registered", []string{"s.Detail"}, "s.Detail", ""},
+		{"nested", []string{"s.Detail.Reason"}, "s.Detail.Reason", ""},
+		{"not found", []string{"s.Missing"}, "", "status_detail s.Missing: message not found in the request"},
+		{"unqualified", []string{"Detail"}, "", "status_detail Detail: message not found in the request"},
+	}
+	files := parseFiles(t, map[string]string{"s.proto": `syntax = "proto3"; package s; message Detail { message Reason {} }`})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			types, err := statusDetailTypes(map[string]*desc.FileDescriptor{"s.proto": files[0]}, &Parameters{StatusDetailTypes: tt.types})
+			checkError(t, err, tt.err)
+			if err != nil {
+				return
+			}
+			if got := types[len(types)-1].GetFullyQualifiedName(); got != tt.want {
+				t.Errorf("got last type %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package gentstypes
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/pkg/errors"
+)
+
+// With Parameters.TemplateDir the files named *.tmpl in the directory are
+// parsed as Go text/template templates, with the sprig functions. The
+// templates named below replace the built-in rendering of an element, the
+// others can be used by them with the template action:
+//
+//  header.tmpl   the first lines of each output file, executed with HeaderData
+//  message.tmpl  the type declarations of each message, with MessageData
+//  enum.tmpl     the declaration of each enum, with EnumData
+//  service.tmpl  the declaration of each service, with ServiceData
+//
+// The output of a template is written as is, with each line indented as the
+// built-in declaration would be. Imports, codecs, schemas, clients and the
+// namespaces of nested messages are still generated around it.
+
+// HeaderData is the data of the header template.
+type HeaderData struct {
+	// Name is the name of the output file.
+	Name   string
+	Files  []*desc.FileDescriptor
+	Params *Parameters
+}
+
+// MessageData is the data of the message template.
+type MessageData struct {
+	Descriptor *desc.MessageDescriptor
+	// Name is the name the message is declared with, and Comment its
+	// leading comment.
+	Name    string
+	Comment string
+	Fields  []FieldData
+	// InputName and InputFields describe the input shape of the message if it
+	// differs from the response shape, otherwise they are empty.
+	InputName   string
+	InputFields []FieldData
+	Params      *Parameters
+}
+
+// FieldData describes a property of a message shape.
+type FieldData struct {
+	Descriptor *desc.FieldDescriptor
+	// Name is the property name, Type its TypeScript type in the shape.
+	Name     string
+	Type     string
+	Comment  string
+	Optional bool
+	ReadOnly bool
+	Options  FieldOptions
+}
+
+// EnumData is the data of the enum template.
+type EnumData struct {
+	Descriptor *desc.EnumDescriptor
+	Name       string
+	Comment    string
+	Values     []EnumValueData
+	Params     *Parameters
+}
+
+// EnumValueData describes a value of an enum. Values left out by
+// Parameters.OmitEnumZeros are not listed.
+type EnumValueData struct {
+	Descriptor *desc.EnumValueDescriptor
+	// Name is the member name, following Parameters.StripEnumPrefix, and
+	// Literal the value, a quoted JSON name or a number with int enums.
+	Name    string
+	Literal string
+	Comment string
+}
+
+// ServiceData is the data of the service template.
+type ServiceData struct {
+	Descriptor *desc.ServiceDescriptor
+	Name       string
+	Comment    string
+	Methods    []MethodData
+	Params     *Parameters
+}
+
+// MethodData describes a method of a service.
+type MethodData struct {
+	Descriptor *desc.MethodDescriptor
+	Name       string
+	Comment    string
+	// InputType and OutputType are the names the request and response types
+	// are referred to by, Signature the member the built-in service interface
+	// declares for the method.
+	InputType  string
+	OutputType string
+	Signature  string
+}
+
+// loadTemplates parses the templates in dir.
+func loadTemplates(dir string) (*template.Template, error) {
+	t, err := template.New("").Funcs(sprig.TxtFuncMap()).ParseGlob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing templates")
+	}
+	return t, nil
+}
+
+// hasTemplate reports whether the template name is loaded.
+func (g *Generator) hasTemplate(name string) bool {
+	return g.templates != nil && g.templates.Lookup(name) != nil
+}
+
+// execTemplate writes the output of the template name executed with data. It
+// reports false if there is no such template.
+func (g *Generator) execTemplate(name string, data interface{}) (bool, error) {
+	if !g.hasTemplate(name) {
+		return false, nil
+	}
+	buf := new(bytes.Buffer)
+	if err := g.templates.ExecuteTemplate(buf, name, data); err != nil {
+		return true, err
+	}
+	for _, line := range strings.SplitAfter(buf.String(), "\n") {
+		if line != "\n" && line != "" {
+			g.Buffer.WriteString(g.indent)
+		}
+		g.Buffer.WriteString(line)
+	}
+	return true, nil
+}
+
+func (g *Generator) messageData(m *desc.MessageDescriptor, params *Parameters) *MessageData {
+	data := &MessageData{
+		Descriptor: m,
+		Name:       declName(m, params),
+		Comment:    m.GetSourceInfo().GetLeadingComments(),
+		Fields:     g.fieldData(m, params),
+		Params:     params,
+	}
+	if needsInput(m, params) {
+		g.inputShape = true
+		data.InputName = data.Name + inputSuffix
+		data.InputFields = g.fieldData(m, params)
+		g.inputShape = false
+	}
+	return data
+}
+
+// fieldData describes the fields of m in the shape being generated.
+func (g *Generator) fieldData(m *desc.MessageDescriptor, params *Parameters) []FieldData {
+	result := []FieldData{}
+	for _, f := range g.shapeFields(m, params) {
+		fOpts := fieldOptions(f, params)
+		result = append(result, FieldData{
+			Descriptor: f,
+			Name:       fieldName(f, params),
+			Type:       g.fieldType(f, params),
+			Comment:    f.GetSourceInfo().GetLeadingComments(),
+			Optional:   !isRequired(f, fOpts, params),
+			ReadOnly:   g.isReadOnly(f, params),
+			Options:    fOpts,
+		})
+	}
+	return result
+}
+
+func enumData(e *desc.EnumDescriptor, params *Parameters) *EnumData {
+	data := &EnumData{
+		Descriptor: e,
+		Name:       declName(e, params),
+		Comment:    e.GetSourceInfo().GetLeadingComments(),
+		Params:     params,
+	}
+	for _, v := range enumValues(e, params) {
+		data.Values = append(data.Values, EnumValueData{
+			Descriptor: v,
+			Name:       enumMemberName(v, params),
+			Literal:    enumValueLiteral(v, params),
+			Comment:    v.GetSourceInfo().GetLeadingComments(),
+		})
+	}
+	return data
+}
+
+func (g *Generator) serviceData(service *desc.ServiceDescriptor, params *Parameters) *ServiceData {
+	data := &ServiceData{
+		Descriptor: service,
+		Name:       service.GetName(),
+		Comment:    service.GetSourceInfo().GetLeadingComments(),
+		Params:     params,
+	}
+	for _, method := range service.GetMethods() {
+		data.Methods = append(data.Methods, MethodData{
+			Descriptor: method,
+			Name:       method.GetName(),
+			Comment:    method.GetSourceInfo().GetLeadingComments(),
+			InputType:  g.inputTypeName(method.GetInputType(), params),
+			OutputType: g.typeName(method.GetOutputType(), params),
+			Signature:  g.serviceMethodSignature(method, params),
+		})
+	}
+	return data
+}
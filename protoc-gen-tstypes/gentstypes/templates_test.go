@@ -0,0 +1,58 @@
+package gentstypes
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// templateDir writes templates, keyed by file name, to a temporary directory
+// and returns it with a function removing it.
+func templateDir(t *testing.T, templates map[string]string) (string, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "tstypes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, s := range templates {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(s), 0644); err != nil {
+			os.RemoveAll(dir)
+			t.Fatal(err)
+		}
+	}
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func TestTemplates(t *testing.T) {
+	const source = `syntax = "proto3"; package t; message M { message N {} string a = 1; } enum E { E_A = 0; }`
+	tests := []struct {
+		name      string
+		templates map[string]string
+		want      string
+		err       string
+	}{
+		{"message", map[string]string{"message.tmpl": "type {{.Name}} = {{len .Fields}};\n"},
+			"    type M_N = 0;\n    type M = 1;\n", ""},
+		{"helper template", map[string]string{"message.tmpl": `{{template "name" .}}` + "\n", "name.tmpl": `{{define "name"}}// {{.Name | lower}}{{end}}`},
+			"    // m\n", ""},
+		{"sprig", map[string]string{"enum.tmpl": `type {{.Name}} = {{range $i, $v := .Values}}{{if $i}} | {{end}}{{$v.Literal}}{{end}}; // {{.Name | upper}}` + "\n"},
+			`    type E = "E_A"; // E`, ""},
+		{"parse error", map[string]string{"message.tmpl": "{{.Name"}, "", "parsing templates: template: message.tmpl:1: unclosed action"},
+		{"unknown function", map[string]string{"message.tmpl": "{{.Name | shout}}"}, "", `parsing templates: template: message.tmpl:1: function "shout" not defined`},
+		{"no templates", map[string]string{"message.txt": ""}, "", "parsing templates: template: pattern matches no files"},
+		{"execution error", map[string]string{"message.tmpl": "{{.Missing}}"}, "", `t.proto: t.M.N: template: message.tmpl:1:2: executing "message.tmpl" at <.Missing>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, remove := templateDir(t, tt.templates)
+			defer remove()
+			files, err := generate(t, map[string]string{"t.proto": source}, &Parameters{DeclareNamespace: true, OutputNamePattern: "{{.BaseName}}.d.ts", TemplateDir: dir})
+			checkError(t, err, tt.err)
+			if got := files["t.d.ts"]; err == nil && !strings.Contains(got, tt.want) {
+				t.Errorf("output does not contain %q:\n%s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package gentstypes
+
+import (
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// wellKnownType returns the TypeScript type matching the canonical proto3 JSON
+// representation of the google.protobuf well-known type m. It returns an
+// empty string if m is not a well-known type with a special JSON mapping.
+func (g *Generator) wellKnownType(m *desc.MessageDescriptor, params *Parameters) string {
+	if isWrapperType(m) {
+		// Wrappers use the representation of the wrapped type, with null
+		// allowed. Bytes are base64 strings unless decoded by the JSON codecs.
+		v := m.FindFieldByName("value")
+		if v.GetType() == descriptor.FieldDescriptorProto_TYPE_BYTES && !params.JSONCodecs {
+			return "string | null"
+		}
+		return g.rawFieldType(v, params) + " | null"
+	}
+	switch m.GetFullyQualifiedName() {
+	case "google.protobuf.Timestamp":
+		// RFC 3339, e.g. "1972-01-01T10:00:20.021Z", decoded to a Date by
+		// the JSON codecs.
+		if params.JSONCodecs {
+			return "Date"
+		}
+		return "string"
+	case "google.protobuf.Duration":
+		// Seconds with an "s" suffix, e.g. "1.5s".
+		return "string"
+	case "google.protobuf.FieldMask":
+		// Comma-joined lowerCamelCase paths, e.g. "user.displayName,photo".
+		return "string"
+	case "google.protobuf.Struct":
+		return "{ [key: string]: any }"
+	case "google.protobuf.Value":
+		return "any"
+	case "google.protobuf.ListValue":
+		return "Array<any>"
+	case "google.protobuf.Any":
+		return "{ \"@type\": string; [key: string]: any }"
+	case "google.protobuf.Empty":
+		return "{}"
+	}
+	return ""
+}
+
+// wrapperTypes are the wrapper well-known types, represented by their wrapped
+// value.
+var wrapperTypes = map[string]bool{
+	"google.protobuf.DoubleValue": true,
+	"google.protobuf.FloatValue":  true,
+	"google.protobuf.Int64Value":  true,
+	"google.protobuf.UInt64Value": true,
+	"google.protobuf.Int32Value":  true,
+	"google.protobuf.UInt32Value": true,
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.StringValue": true,
+	"google.protobuf.BytesValue":  true,
+}
+
+func isWrapperType(m *desc.MessageDescriptor) bool {
+	return wrapperTypes[m.GetFullyQualifiedName()]
+}
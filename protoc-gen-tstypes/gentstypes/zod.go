@@ -0,0 +1,245 @@
+package gentstypes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// The zod schemas validate values of the generated types at runtime. Each
+// schema is declared with the type it validates, so z.infer of a schema is
+// the generated type. Message schemas are lazy, they may refer to schemas
+// declared later in the file or to themselves.
+
+const schemaSuffix = "Schema"
+
+// zodHelpers holds the module private schemas used by the generated schemas,
+// they are only written to files that use them.
+var zodHelpers = map[string]string{
+	// Without the JSON codecs bytes are base64 strings in JSON, the schema
+	// decodes them so that parsed values are Uint8Array as typed.
+	"bytesSchema": `const bytesSchema = z.custom<Uint8Array>((v) => typeof v === "string" || v instanceof Uint8Array).transform((v: Uint8Array | string, ctx) => {
+    if (typeof v !== "string") {
+        return v;
+    }
+    try {
+        const s = atob(v.replace(/-/g, "+").replace(/_/g, "/"));
+        const b = new Uint8Array(s.length);
+        for (let i = 0; i < s.length; i++) {
+            b[i] = s.charCodeAt(i);
+        }
+        return b;
+    } catch (e) {
+        ctx.addIssue({ code: z.ZodIssueCode.custom, message: "invalid base64" });
+        return z.NEVER;
+    }
+});`,
+}
+
+// reserveZodNames reserves the names of the schemas and helpers declared by
+// the output of files and imports zod.
+func (g *Generator) reserveZodNames(files []*desc.FileDescriptor) {
+	for _, f := range files {
+		for _, d := range fileTypes(f) {
+			g.imports.reserve(packageQualifiedName(d) + schemaSuffix)
+		}
+	}
+	for n := range zodHelpers {
+		g.imports.reserve(n)
+	}
+	g.imports.addPackage("zod", "z")
+}
+
+// generateEnumSchema writes the schema of e. Unions and const enums have no
+// object to validate against, their schemas list the values.
+func (g *Generator) generateEnumSchema(e *desc.EnumDescriptor, params *Parameters) {
+	name := declName(e, params)
+	decl := fmt.Sprintf("export const %s%s = ", name, schemaSuffix)
+	if params.EnumStyle != EnumStyleUnion && params.EnumStyle != EnumStyleConstEnum {
+		g.W(fmt.Sprintf("%sz.nativeEnum(%s);\n", decl, name))
+		return
+	}
+	refs := []string{}
+	for _, v := range enumValues(e, params) {
+		refs = append(refs, enumValueRef(name, v, params))
+	}
+	switch {
+	case len(refs) == 0:
+		g.W(decl + "z.never();\n")
+	case !params.EnumsAsInt:
+		g.W(fmt.Sprintf("%sz.enum([%s]);\n", decl, strings.Join(refs, ", ")))
+	case len(refs) == 1:
+		g.W(fmt.Sprintf("%sz.literal(%s);\n", decl, refs[0]))
+	default:
+		literals := []string{}
+		for _, r := range refs {
+			literals = append(literals, fmt.Sprintf("z.literal(%s)", r))
+		}
+		g.W(fmt.Sprintf("%sz.union([%s]);\n", decl, strings.Join(literals, ", ")))
+	}
+}
+
+func (g *Generator) generateMessageSchema(m *desc.MessageDescriptor, params *Parameters) {
+	name := declName(m, params)
+	oneOfs := []*desc.OneOfDescriptor{}
+	if params.OneofsAsUnions {
+		for _, o := range m.GetOneOfs() {
+			if !o.IsSynthetic() && len(g.inShape(o.GetChoices(), params)) > 0 {
+				oneOfs = append(oneOfs, o)
+			}
+		}
+	}
+	regular := []*desc.FieldDescriptor{}
+	for _, f := range g.shapeFields(m, params) {
+		if o := f.GetOneOf(); len(oneOfs) == 0 || o == nil || o.IsSynthetic() {
+			regular = append(regular, f)
+		}
+	}
+	decl := fmt.Sprintf("export const %s%s: z.ZodType<%s> = z.lazy(() => ", name, schemaSuffix, name)
+	// As in the generated type, each oneof is a union of objects in which
+	// exactly one member (or none of them) is set, intersected with the
+	// regular fields.
+	if len(regular) > 0 || len(oneOfs) == 0 {
+		g.W(decl + "z.object({")
+		for _, f := range regular {
+			g.generateFieldSchema(f, isRequired(f, fieldOptions(f, params), params), params)
+		}
+		decl = "})"
+	}
+	for i, o := range oneOfs {
+		if i > 0 || len(regular) > 0 {
+			g.W(decl + ".and(z.union([")
+		} else {
+			g.W(decl + "z.union([")
+		}
+		g.incIndent()
+		for _, f := range g.inShape(o.GetChoices(), params) {
+			g.generateOneOfChoiceSchema(o, f, params)
+		}
+		g.generateOneOfChoiceSchema(o, nil, params)
+		g.decIndent()
+		if i > 0 || len(regular) > 0 {
+			decl = "]))"
+		} else {
+			decl = "])"
+		}
+	}
+	g.W(decl + ");\n")
+}
+
+// generateOneOfChoiceSchema writes the object schema of the union variant of o
+// in which only the field selected is set. If selected is nil no member is
+// set.
+func (g *Generator) generateOneOfChoiceSchema(o *desc.OneOfDescriptor, selected *desc.FieldDescriptor, params *Parameters) {
+	g.W("z.object({")
+	for _, f := range g.inShape(o.GetChoices(), params) {
+		if f == selected {
+			g.generateFieldSchema(f, true, params)
+			continue
+		}
+		g.W(fmt.Sprintf(indent+"%s: z.undefined().optional(),", fieldName(f, params)))
+	}
+	g.W("}),")
+}
+
+func (g *Generator) generateFieldSchema(f *desc.FieldDescriptor, required bool, params *Parameters) {
+	s := g.fieldSchemaExpr(f, params)
+	if !required {
+		s += ".optional()"
+	}
+	g.W(fmt.Sprintf(indent+"%s: %s,", fieldName(f, params), s))
+}
+
+// fieldSchemaExpr returns the zod schema of the field f.
+func (g *Generator) fieldSchemaExpr(f *desc.FieldDescriptor, params *Parameters) string {
+	if f.IsMap() {
+		// Object keys are strings, whatever the key type.
+		return fmt.Sprintf("z.record(z.string(), %s)", g.valueSchemaExpr(f.GetMapValueType(), params))
+	}
+	if f.IsRepeated() {
+		return fmt.Sprintf("z.array(%s)", g.valueSchemaExpr(f, params))
+	}
+	return g.valueSchemaExpr(f, params)
+}
+
+// valueSchemaExpr returns the zod schema of a single value of the field f.
+func (g *Generator) valueSchemaExpr(f *desc.FieldDescriptor, params *Parameters) string {
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return "z.boolean()"
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return "z.string()"
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		if params.JSONCodecs {
+			return "z.instanceof(Uint8Array)"
+		}
+		return g.helper("bytesSchema")
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return g.codecName(f.GetEnumType(), schemaSuffix, params)
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		t := f.GetMessageType()
+		if params.WellKnownTypesAsJSON {
+			if isWrapperType(t) {
+				v := t.FindFieldByName("value")
+				if isInt64(v) {
+					// The options of f apply to the wrapped value.
+					return int64SchemaExpr(int64Representation(f, params)) + ".nullable()"
+				}
+				if v.GetType() == descriptor.FieldDescriptorProto_TYPE_BYTES && !params.JSONCodecs {
+					return "z.string().nullable()"
+				}
+				return g.valueSchemaExpr(v, params) + ".nullable()"
+			}
+			if params.AnyTypes && isAny(f) {
+				return g.anySchemaExpr(f, params)
+			}
+			if s := wellKnownTypeSchemaExpr(t, params); s != "" {
+				return s
+			}
+		}
+		return g.codecName(t, schemaSuffix, params)
+	}
+	if isInt64(f) {
+		return int64SchemaExpr(int64Representation(f, params))
+	}
+	return "z.number()"
+}
+
+func int64SchemaExpr(r Int64Representation) string {
+	switch r {
+	case Int64String, Int64StringNumber:
+		// The schemas validate responses and decoded messages.
+		return "z.string()"
+	case Int64BigInt:
+		return "z.bigint()"
+	}
+	return "z.number()"
+}
+
+// wellKnownTypeSchemaExpr returns the zod schema of the well-known type m, or
+// an empty string if m has no special representation. It matches
+// wellKnownType.
+func wellKnownTypeSchemaExpr(m *desc.MessageDescriptor, params *Parameters) string {
+	switch m.GetFullyQualifiedName() {
+	case "google.protobuf.Timestamp":
+		if params.JSONCodecs {
+			return "z.date()"
+		}
+		return "z.string()"
+	case "google.protobuf.Duration", "google.protobuf.FieldMask":
+		return "z.string()"
+	case "google.protobuf.Struct":
+		return "z.record(z.string(), z.any())"
+	case "google.protobuf.Value":
+		return "z.any()"
+	case "google.protobuf.ListValue":
+		return "z.array(z.any())"
+	case "google.protobuf.Any":
+		return `z.object({ "@type": z.string() }).passthrough()`
+	case "google.protobuf.Empty":
+		return "z.object({}).passthrough()"
+	}
+	return ""
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/golang/protobuf/proto"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 	"github.com/pkg/errors"
 	"github.com/tmc/grpcutil/protoc-gen-tstypes/gentstypes"
 	"golang.org/x/crypto/ssh/terminal"
@@ -22,8 +23,56 @@ var (
 	flagOutputFilenamePattern = flag.String("outpattern", "{{.Dir}}/{{.Descriptor.GetPackage | default \"none\"}}.{{.BaseName}}.d.ts", "output filename pattern")
 	flagDumpDescriptor        = flag.Bool("dump_request_descriptor", false, "if true, dump request descriptor")
 	flagInt64AsString         = flag.Bool("int64_string", false, "if true, use string representation for 64 bit numbers")
+	flagInt64                 = flag.String("int64", "", "representation of 64 bit numbers: number, string, bigint or string_number (overrides int64_string)")
+	flagWellKnownTypesAsJSON  = flag.Bool("wkt_json", false, "if true, use the canonical JSON representation for well-known types")
+	flagOneofsAsUnions        = flag.Bool("oneof_unions", false, "if true, generate oneofs as unions of mutually exclusive members, otherwise as independent optional fields")
+	flagEmitDefaults          = flag.Bool("emit_defaults", false, "if true, fields without explicit presence are not optional, matching a marshaler that emits default values")
+	flagESModules             = flag.Bool("es_modules", false, "if true, generate ES modules that import referenced types instead of namespace declarations")
+	flagJSONCodecs            = flag.Bool("json_codecs", false, "if true, generate functions converting messages and enums from and to their JSON representation (requires es_modules and wkt_json)")
+	flagNestedNamespaces      = flag.Bool("nested_namespaces", false, "if true, generate nested messages and enums in a namespace merged with their parent message, otherwise flatten their names with underscores")
+	flagJSONSchema            = flag.Bool("json_schema", false, "if true, generate a JSON Schema next to each TypeScript file")
+	flagHTTPClient            = flag.Bool("http_client", false, "if true, generate a client class per service calling the REST endpoints declared with google.api.http (requires es_modules and json_codecs)")
+	flagGRPCWeb               = flag.Bool("grpc_web", false, "if true, generate a grpc-web client class per service, only application/grpc-web+json is encoded (requires es_modules)")
+	flagCallOptions           = flag.Bool("call_options", false, "if true, service methods take call options and return the response with the headers, trailers and status of the call (requires es_modules)")
+	flagStatusDetails         = flag.Bool("status_details", false, "if true, files declaring services also declare the known google.rpc.Status detail types, from google/rpc/error_details.proto and status_detail, with helpers narrowing them (requires es_modules)")
+	flagStatusDetailTypes     stringList
+	flagAnyTypes              = flag.Bool("any_types", false, "if true, add the messages of each file to the global TypeRegistry interface and type google.protobuf.Any fields as the union of the registered messages (requires wkt_json)")
+	flagServerHandlers        = flag.Bool("server_handlers", false, "if true, generate a handler interface per service for Node gRPC servers (requires es_modules)")
+	flagZod                   = flag.Bool("zod", false, "if true, generate a zod schema validating each message and enum at runtime (requires es_modules)")
+	flagBundle                = flag.String("bundle", "", "if set, generate all files into a single output file with this name, with one namespace per package")
+	flagDependencies          = flag.Bool("deps", false, "if true, also generate the files declaring types referenced by the generated files that protoc was not asked to generate")
+	flagDependencyAllow       stringList
+	flagDependencyDeny        stringList
+	flagJSDoc                 = flag.Bool("jsdoc", false, "if true, document messages, fields, enums, enum values, services and methods with JSDoc blocks including deprecation and field numbers")
+	flagEnumStyle             = flag.String("enum_style", "enum", "declaration of enums: enum, union, const_object or const_enum")
+	flagStripEnumPrefix       = flag.Bool("strip_enum_prefix", false, "if true, remove the enum name prefix from member names, e.g. COLOR_RED of Color becomes RED")
+	flagEnumZeros             = flag.Bool("enum_zeros", true, "if false, leave out enum values of value zero")
+	flagInputTypes            = flag.Bool("input_types", false, "if true, follow google.api.field_behavior and generate separate <Name>Input types for requests")
+	flagTemplateDir           = flag.String("template_dir", "", "if set, render file headers, messages, enums and services with the *.tmpl templates in this directory")
 )
 
+func init() {
+	flag.Var(&flagDependencyAllow, "deps_allow", "package whose files deps generates, with its subpackages (repeatable, default all)")
+	flag.Var(&flagDependencyDeny, "deps_deny", "package whose files deps does not generate, with its subpackages (repeatable)")
+	flag.Var(&flagStatusDetailTypes, "status_detail", "fully qualified name of a message status_details adds to the known detail types (repeatable)")
+}
+
+// stringList is a flag that may be set several times.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+// importMap holds the M parameters, mapping proto files to the module
+// specifiers their types are imported from.
+var importMap = map[string]string{}
+
 func main() {
 	g := gentstypes.New()
 	if terminal.IsTerminal(0) {
@@ -38,45 +87,166 @@ func main() {
 		log.Fatalln(errors.Wrap(err, "parsing input"))
 	}
 	if len(g.Request.FileToGenerate) == 0 {
-		log.Fatalln(errors.Wrap(err, "no files to generate"))
+		emitError(g.Response, errors.New("no files to generate"))
+		return
+	}
+	params, err := parameters(g.Request.Parameter)
+	if err != nil {
+		emitError(g.Response, err)
+		return
+	}
+	if err := g.GenerateAllFiles(params); err != nil {
+		emitError(g.Response, err)
+		return
+	}
+	emitResp(g.Response)
+}
+
+// parameters parses the plugin parameter s and returns the generator
+// parameters it selects.
+func parameters(s *string) (*gentstypes.Parameters, error) {
+	if err := parseFlags(s); err != nil {
+		return nil, err
+	}
+	var int64 gentstypes.Int64Representation
+	if *flagInt64 != "" {
+		var err error
+		if int64, err = gentstypes.ParseInt64Representation(*flagInt64); err != nil {
+			return nil, err
+		}
+	}
+	enumStyle, err := gentstypes.ParseEnumStyle(*flagEnumStyle)
+	if err != nil {
+		return nil, err
+	}
+	if *flagHTTPClient && !*flagESModules {
+		return nil, errors.New("http_client requires es_modules")
+	}
+	if *flagGRPCWeb && !*flagESModules {
+		return nil, errors.New("grpc_web requires es_modules")
 	}
-	parseFlags(g.Request.Parameter)
-	g.GenerateAllFiles(&gentstypes.Parameters{
+	if *flagCallOptions && !*flagESModules {
+		return nil, errors.New("call_options requires es_modules")
+	}
+	if *flagStatusDetails && !*flagESModules {
+		return nil, errors.New("status_details requires es_modules")
+	}
+	if len(flagStatusDetailTypes) > 0 && !*flagStatusDetails {
+		return nil, errors.New("status_detail requires status_details")
+	}
+	if *flagAnyTypes && !*flagWellKnownTypesAsJSON {
+		return nil, errors.New("any_types requires wkt_json")
+	}
+	if *flagServerHandlers && !*flagESModules {
+		return nil, errors.New("server_handlers requires es_modules")
+	}
+	if *flagZod && !*flagESModules {
+		return nil, errors.New("zod requires es_modules")
+	}
+	if *flagBundle != "" && !*flagDeclareNamespace {
+		return nil, errors.New("bundle requires declare_namespace")
+	}
+	if len(importMap) > 0 && !*flagESModules {
+		return nil, errors.New("M import mappings require es_modules")
+	}
+	outputFilenamePattern := *flagOutputFilenamePattern
+	if *flagESModules && !isFlagSet("outpattern") {
+		outputFilenamePattern = moduleOutputFilenamePattern
+	}
+	return &gentstypes.Parameters{
 		AsyncIterators:        *flagAsyncIterators,
 		DeclareNamespace:      *flagDeclareNamespace,
 		Verbose:               *flagVerbose,
-		OutputNamePattern:     *flagOutputFilenamePattern,
+		OutputNamePattern:     outputFilenamePattern,
 		EnumsAsInt:            *flagEnumsAsInts,
 		OriginalNames:         *flagOriginalNames,
 		DumpRequestDescriptor: *flagDumpDescriptor,
 		Int64AsString:         *flagInt64AsString,
-	})
-	data, err = proto.Marshal(g.Response)
+		Int64:                 int64,
+		WellKnownTypesAsJSON:  *flagWellKnownTypesAsJSON,
+		OneofsAsUnions:        *flagOneofsAsUnions,
+		EmitDefaults:          *flagEmitDefaults,
+		ESModules:             *flagESModules,
+		JSONCodecs:            *flagJSONCodecs,
+		HTTPClient:            *flagHTTPClient,
+		GRPCWeb:               *flagGRPCWeb,
+		ServerHandlers:        *flagServerHandlers,
+		CallOptions:           *flagCallOptions,
+		StatusDetails:         *flagStatusDetails,
+		StatusDetailTypes:     flagStatusDetailTypes,
+		AnyTypes:              *flagAnyTypes,
+		NestedNamespaces:      *flagNestedNamespaces,
+		JSONSchema:            *flagJSONSchema,
+		Zod:                   *flagZod,
+		ImportMap:             importMap,
+		Bundle:                *flagBundle,
+		Dependencies:          *flagDependencies,
+		DependencyAllow:       flagDependencyAllow,
+		DependencyDeny:        flagDependencyDeny,
+		JSDoc:                 *flagJSDoc,
+		EnumStyle:             enumStyle,
+		StripEnumPrefix:       *flagStripEnumPrefix,
+		OmitEnumZeros:         !*flagEnumZeros,
+		InputTypes:            *flagInputTypes,
+		TemplateDir:           *flagTemplateDir,
+	}, nil
+}
+
+// emitError reports err to protoc, which prints it and fails. The supported
+// features of resp are kept so that protoc shows err rather than complaining
+// about proto3 optional fields.
+func emitError(resp *plugin.CodeGeneratorResponse, err error) {
+	resp.File = nil
+	resp.Error = proto.String(err.Error())
+	emitResp(resp)
+}
+
+func emitResp(resp *plugin.CodeGeneratorResponse) {
+	data, err := proto.Marshal(resp)
 	if err != nil {
 		log.Fatalln(errors.Wrap(err, "failed to marshal output proto"))
 	}
-	_, err = os.Stdout.Write(data)
-	if err != nil {
+	if _, err := os.Stdout.Write(data); err != nil {
 		log.Fatalln(errors.Wrap(err, "failed to write output proto"))
 	}
 }
 
-func parseFlags(s *string) {
+// moduleOutputFilenamePattern is the default output filename pattern in
+// module mode, where files contain code rather than ambient declarations.
+const moduleOutputFilenamePattern = "{{.Dir}}/{{.Descriptor.GetPackage | default \"none\"}}.{{.BaseName}}.ts"
+
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+func parseFlags(s *string) error {
 	if s == nil {
-		return
+		return nil
 	}
 	for _, p := range strings.Split(*s, ",") {
 		spec := strings.SplitN(p, "=", 2)
 		if len(spec) == 1 {
 			if err := flag.CommandLine.Set(spec[0], ""); err != nil {
-				log.Fatalln("Cannot set flag", p, err)
+				return errors.Wrapf(err, "cannot set flag %s", p)
 			}
 			continue
 		}
 		name, value := spec[0], spec[1]
-		// TODO: consider supporting package mapping (M flag)
+		// Mpath/to/file.proto=module imports the types of the file from
+		// module, as the M parameters of protoc-gen-go.
+		if strings.HasPrefix(name, "M") {
+			importMap[name[1:]] = value
+			continue
+		}
 		if err := flag.CommandLine.Set(name, value); err != nil {
-			log.Fatalln("Cannot set flag", p)
+			return errors.Wrapf(err, "cannot set flag %s", p)
 		}
 	}
+	return nil
 }
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	"github.com/pkg/errors"
+	"github.com/tmc/grpcutil/protoc-gen-tstypes/gentstypes"
+)
+
+// captureStdout returns what f writes to the standard output.
+func captureStdout(t *testing.T, f func()) []byte {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+	f()
+	w.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestEmitError(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []*plugin.CodeGeneratorResponse_File
+		err   error
+	}{
+		{"no files", nil, errors.New("no files to generate")},
+		{"generated files", []*plugin.CodeGeneratorResponse_File{{Name: proto.String("a.ts"), Content: proto.String("")}},
+			errors.Wrap(errors.New("message x not found"), "a.proto")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gentstypes.New()
+			g.Response.File = tt.files
+			data := captureStdout(t, func() { emitError(g.Response, tt.err) })
+			resp := &plugin.CodeGeneratorResponse{}
+			if err := proto.Unmarshal(data, resp); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := resp.GetError(), tt.err.Error(); got != want {
+				t.Errorf("got error %q, want %q", got, want)
+			}
+			if len(resp.File) != 0 {
+				t.Errorf("got %d files, want none", len(resp.File))
+			}
+			if resp.GetSupportedFeatures()&uint64(plugin.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL) == 0 {
+				t.Error("proto3 optional support is not reported")
+			}
+		})
+	}
+}
+
+// resetFlags sets the flags of the plugin back to their defaults, parameters
+// sets them on the global flag set.
+func resetFlags(t *testing.T) {
+	t.Helper()
+	flag.VisitAll(func(f *flag.Flag) {
+		if _, ok := f.Value.(*stringList); ok || strings.HasPrefix(f.Name, "test.") {
+			return
+		}
+		if err := f.Value.Set(f.DefValue); err != nil {
+			t.Fatal(err)
+		}
+	})
+	flagDependencyAllow, flagDependencyDeny, flagStatusDetailTypes = nil, nil, nil
+	importMap = map[string]string{}
+}
+
+func TestParametersImportMap(t *testing.T) {
+	tests := []struct {
+		name      string
+		parameter string
+		want      map[string]string
+		err       string
+	}{
+		{"none", "es_modules=true", map[string]string{}, ""},
+		{"files", "es_modules=true,Mroute_guide.proto=@example/protos/routeguide,Mgoogle/api/http.proto=./http",
+			map[string]string{"route_guide.proto": "@example/protos/routeguide", "google/api/http.proto": "./http"}, ""},
+		{"value with equals", "es_modules=true,Ma.proto=./a?v=1", map[string]string{"a.proto": "./a?v=1"}, ""},
+		{"last wins", "es_modules=true,Ma.proto=./a,Ma.proto=./b", map[string]string{"a.proto": "./b"}, ""},
+		{"without es_modules", "Ma.proto=./a", nil, "M import mappings require es_modules"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetFlags(t)
+			defer resetFlags(t)
+			params, err := parameters(proto.String(tt.parameter))
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("got error %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(params.ImportMap, tt.want) {
+				t.Errorf("got import map %v, want %v", params.ImportMap, tt.want)
+			}
+		})
+	}
+}
+
+func TestParametersRequirements(t *testing.T) {
+	tests := []struct {
+		name      string
+		parameter string
+		err       string
+	}{
+		{"bundle", "bundle=protos.d.ts", ""},
+		{"bundle without declare_namespace", "bundle=protos.d.ts,declare_namespace=false", "bundle requires declare_namespace"},
+		{"grpc_web", "grpc_web=true,es_modules=true", ""},
+		{"grpc_web without es_modules", "grpc_web=true", "grpc_web requires es_modules"},
+		{"server_handlers", "server_handlers=true,es_modules=true", ""},
+		{"server_handlers without es_modules", "server_handlers=true", "server_handlers requires es_modules"},
+		{"call_options", "call_options=true,es_modules=true", ""},
+		{"call_options without es_modules", "call_options=true", "call_options requires es_modules"},
+		{"status_details", "status_details=true,es_modules=true,status_detail=a.B", ""},
+		{"status_details without es_modules", "status_details=true", "status_details requires es_modules"},
+		{"status_detail without status_details", "es_modules=true,status_detail=a.B", "status_detail requires status_details"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetFlags(t)
+			defer resetFlags(t)
+			_, err := parameters(proto.String(tt.parameter))
+			switch {
+			case tt.err == "" && err != nil:
+				t.Errorf("got error %q, want none", err)
+			case tt.err != "" && (err == nil || err.Error() != tt.err):
+				t.Errorf("got error %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
@@ -27,6 +27,75 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type Int64Representation int32
+
+const (
+	// Use the representation selected by the int64 parameter.
+	Int64Representation_INT64_DEFAULT Int64Representation = 0
+	Int64Representation_INT64_STRING  Int64Representation = 1
+	Int64Representation_INT64_NUMBER  Int64Representation = 2
+	// Decoded to a bigint by the JSON codecs, a string without them.
+	Int64Representation_INT64_BIGINT Int64Representation = 3
+	// A string or a number in requests, a string in responses and decoded
+	// messages.
+	Int64Representation_INT64_STRING_NUMBER Int64Representation = 4
+)
+
+// Enum value maps for Int64Representation.
+var (
+	Int64Representation_name = map[int32]string{
+		0: "INT64_DEFAULT",
+		1: "INT64_STRING",
+		2: "INT64_NUMBER",
+		3: "INT64_BIGINT",
+		4: "INT64_STRING_NUMBER",
+	}
+	Int64Representation_value = map[string]int32{
+		"INT64_DEFAULT":       0,
+		"INT64_STRING":        1,
+		"INT64_NUMBER":        2,
+		"INT64_BIGINT":        3,
+		"INT64_STRING_NUMBER": 4,
+	}
+)
+
+func (x Int64Representation) Enum() *Int64Representation {
+	p := new(Int64Representation)
+	*p = x
+	return p
+}
+
+func (x Int64Representation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Int64Representation) Descriptor() protoreflect.EnumDescriptor {
+	return file_opts_proto_enumTypes[0].Descriptor()
+}
+
+func (Int64Representation) Type() protoreflect.EnumType {
+	return &file_opts_proto_enumTypes[0]
+}
+
+func (x Int64Representation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Int64Representation) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Int64Representation(num)
+	return nil
+}
+
+// Deprecated: Use Int64Representation.Descriptor instead.
+func (Int64Representation) EnumDescriptor() ([]byte, []int) {
+	return file_opts_proto_rawDescGZIP(), []int{0}
+}
+
 type Options struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -35,6 +104,11 @@ type Options struct {
 	// Denotes that a field should not be considered optional.
 	Required      *bool                      `protobuf:"varint,1,opt,name=required" json:"required,omitempty"`
 	FieldBehavior *annotations.FieldBehavior `protobuf:"varint,2,opt,name=field_behavior,json=fieldBehavior,enum=google.api.FieldBehavior" json:"field_behavior,omitempty"`
+	// Selects the TypeScript representation of 64 bit integer fields.
+	Int64 *Int64Representation `protobuf:"varint,3,opt,name=int64,enum=opts.Int64Representation" json:"int64,omitempty"`
+	// Restricts the union of a google.protobuf.Any field to the messages with
+	// these fully qualified names, e.g. "library.Book".
+	AnyTypes []string `protobuf:"bytes,4,rep,name=any_types,json=anyTypes" json:"any_types,omitempty"`
 }
 
 func (x *Options) Reset() {
@@ -83,6 +157,20 @@ func (x *Options) GetFieldBehavior() annotations.FieldBehavior {
 	return annotations.FieldBehavior_FIELD_BEHAVIOR_UNSPECIFIED
 }
 
+func (x *Options) GetInt64() Int64Representation {
+	if x != nil && x.Int64 != nil {
+		return *x.Int64
+	}
+	return Int64Representation_INT64_DEFAULT
+}
+
+func (x *Options) GetAnyTypes() []string {
+	if x != nil {
+		return x.AnyTypes
+	}
+	return nil
+}
+
 var file_opts_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptor.MessageOptions)(nil),
@@ -122,23 +210,36 @@ var file_opts_proto_rawDesc = []byte{
 	0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
 	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x67, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0e,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52,
-	0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x3a, 0x56,
-	0x0a, 0x0e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
-	0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x8b, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x70, 0x74, 0x73, 0x2e,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x43, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x8b,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x70, 0x74, 0x73, 0x2e, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x01, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x40, 0x0a,
+	0x0e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x52, 0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12,
+	0x2f, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x6f, 0x70, 0x74, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x65, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6e, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2a, 0x77, 0x0a,
+	0x13, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x5f, 0x44, 0x45,
+	0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x54, 0x36, 0x34,
+	0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x54,
+	0x36, 0x34, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x49,
+	0x4e, 0x54, 0x36, 0x34, 0x5f, 0x42, 0x49, 0x47, 0x49, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x17, 0x0a,
+	0x13, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x55,
+	0x4d, 0x42, 0x45, 0x52, 0x10, 0x04, 0x3a, 0x56, 0x0a, 0x0e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x8b, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x6f, 0x70, 0x74, 0x73, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x43,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x8b, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x6f, 0x70, 0x74, 0x73, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64,
 }
 
 var (
@@ -153,24 +254,27 @@ func file_opts_proto_rawDescGZIP() []byte {
 	return file_opts_proto_rawDescData
 }
 
+var file_opts_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_opts_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_opts_proto_goTypes = []interface{}{
-	(*Options)(nil),                   // 0: opts.Options
-	(annotations.FieldBehavior)(0),    // 1: google.api.FieldBehavior
-	(*descriptor.MessageOptions)(nil), // 2: google.protobuf.MessageOptions
-	(*descriptor.FieldOptions)(nil),   // 3: google.protobuf.FieldOptions
+	(Int64Representation)(0),          // 0: opts.Int64Representation
+	(*Options)(nil),                   // 1: opts.Options
+	(annotations.FieldBehavior)(0),    // 2: google.api.FieldBehavior
+	(*descriptor.MessageOptions)(nil), // 3: google.protobuf.MessageOptions
+	(*descriptor.FieldOptions)(nil),   // 4: google.protobuf.FieldOptions
 }
 var file_opts_proto_depIdxs = []int32{
-	1, // 0: opts.Options.field_behavior:type_name -> google.api.FieldBehavior
-	2, // 1: opts.field_defaults:extendee -> google.protobuf.MessageOptions
-	3, // 2: opts.field:extendee -> google.protobuf.FieldOptions
-	0, // 3: opts.field_defaults:type_name -> opts.Options
-	0, // 4: opts.field:type_name -> opts.Options
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	3, // [3:5] is the sub-list for extension type_name
-	1, // [1:3] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: opts.Options.field_behavior:type_name -> google.api.FieldBehavior
+	0, // 1: opts.Options.int64:type_name -> opts.Int64Representation
+	3, // 2: opts.field_defaults:extendee -> google.protobuf.MessageOptions
+	4, // 3: opts.field:extendee -> google.protobuf.FieldOptions
+	1, // 4: opts.field_defaults:type_name -> opts.Options
+	1, // 5: opts.field:type_name -> opts.Options
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	4, // [4:6] is the sub-list for extension type_name
+	2, // [2:4] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_opts_proto_init() }
@@ -197,13 +301,14 @@ func file_opts_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_opts_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 2,
 			NumServices:   0,
 		},
 		GoTypes:           file_opts_proto_goTypes,
 		DependencyIndexes: file_opts_proto_depIdxs,
+		EnumInfos:         file_opts_proto_enumTypes,
 		MessageInfos:      file_opts_proto_msgTypes,
 		ExtensionInfos:    file_opts_proto_extTypes,
 	}.Build()
@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+import { z } from "zod";
+import type { Empty } from "./google/protobuf/google.protobuf.empty";
+
+export interface Book {
+    name?: string;
+    title?: string;
+    authors?: Array<string>;
+}
+
+export function BookFromJSON(json: any): Book {
+    const m: any = {};
+    let v: any;
+    if ((v = json["name"]) != null) {
+        m.name = String(v);
+    }
+    if ((v = json["title"]) != null) {
+        m.title = String(v);
+    }
+    if ((v = json["authors"]) != null) {
+        m.authors = v.map((e: any) => String(e));
+    }
+    return m as Book;
+}
+
+export function BookToJSON(m: Book): any {
+    const json: any = {};
+    if (m.name !== undefined) {
+        json["name"] = m.name;
+    }
+    if (m.title !== undefined) {
+        json["title"] = m.title;
+    }
+    if (m.authors !== undefined) {
+        json["authors"] = m.authors.map((e: any) => e);
+    }
+    return json;
+}
+
+export const BookSchema: z.ZodType<Book> = z.lazy(() => z.object({
+    name: z.string().optional(),
+    title: z.string().optional(),
+    authors: z.array(z.string()).optional(),
+}));
+
+export interface GetBookRequest {
+    // Resource name of the book, e.g. "shelves/1/books/2".
+    name?: string;
+}
+
+export function GetBookRequestFromJSON(json: any): GetBookRequest {
+    const m: any = {};
+    let v: any;
+    if ((v = json["name"]) != null) {
+        m.name = String(v);
+    }
+    return m as GetBookRequest;
+}
+
+export function GetBookRequestToJSON(m: GetBookRequest): any {
+    const json: any = {};
+    if (m.name !== undefined) {
+        json["name"] = m.name;
+    }
+    return json;
+}
+
+export const GetBookRequestSchema: z.ZodType<GetBookRequest> = z.lazy(() => z.object({
+    name: z.string().optional(),
+}));
+
+export interface ListBooksRequest_Filter {
+    author?: string;
+}
+
+export function ListBooksRequest_FilterFromJSON(json: any): ListBooksRequest_Filter {
+    const m: any = {};
+    let v: any;
+    if ((v = json["author"]) != null) {
+        m.author = String(v);
+    }
+    return m as ListBooksRequest_Filter;
+}
+
+export function ListBooksRequest_FilterToJSON(m: ListBooksRequest_Filter): any {
+    const json: any = {};
+    if (m.author !== undefined) {
+        json["author"] = m.author;
+    }
+    return json;
+}
+
+export const ListBooksRequest_FilterSchema: z.ZodType<ListBooksRequest_Filter> = z.lazy(() => z.object({
+    author: z.string().optional(),
+}));
+
+export interface ListBooksRequest {
+    parent?: string;
+    page_size?: number;
+    page_token?: string;
+    filter?: ListBooksRequest_Filter;
+}
+
+export function ListBooksRequestFromJSON(json: any): ListBooksRequest {
+    const m: any = {};
+    let v: any;
+    if ((v = json["parent"]) != null) {
+        m.parent = String(v);
+    }
+    if ((v = jsonField(json, "page_size", "pageSize")) != null) {
+        m.page_size = Number(v);
+    }
+    if ((v = jsonField(json, "page_token", "pageToken")) != null) {
+        m.page_token = String(v);
+    }
+    if ((v = json["filter"]) != null) {
+        m.filter = ListBooksRequest_FilterFromJSON(v);
+    }
+    return m as ListBooksRequest;
+}
+
+export function ListBooksRequestToJSON(m: ListBooksRequest): any {
+    const json: any = {};
+    if (m.parent !== undefined) {
+        json["parent"] = m.parent;
+    }
+    if (m.page_size !== undefined) {
+        json["page_size"] = m.page_size;
+    }
+    if (m.page_token !== undefined) {
+        json["page_token"] = m.page_token;
+    }
+    if (m.filter !== undefined) {
+        json["filter"] = ListBooksRequest_FilterToJSON(m.filter);
+    }
+    return json;
+}
+
+export const ListBooksRequestSchema: z.ZodType<ListBooksRequest> = z.lazy(() => z.object({
+    parent: z.string().optional(),
+    page_size: z.number().optional(),
+    page_token: z.string().optional(),
+    filter: ListBooksRequest_FilterSchema.optional(),
+}));
+
+export interface ListBooksResponse {
+    books?: Array<Book>;
+    next_page_token?: string;
+}
+
+export function ListBooksResponseFromJSON(json: any): ListBooksResponse {
+    const m: any = {};
+    let v: any;
+    if ((v = json["books"]) != null) {
+        m.books = v.map((e: any) => BookFromJSON(e));
+    }
+    if ((v = jsonField(json, "next_page_token", "nextPageToken")) != null) {
+        m.next_page_token = String(v);
+    }
+    return m as ListBooksResponse;
+}
+
+export function ListBooksResponseToJSON(m: ListBooksResponse): any {
+    const json: any = {};
+    if (m.books !== undefined) {
+        json["books"] = m.books.map((e: any) => BookToJSON(e));
+    }
+    if (m.next_page_token !== undefined) {
+        json["next_page_token"] = m.next_page_token;
+    }
+    return json;
+}
+
+export const ListBooksResponseSchema: z.ZodType<ListBooksResponse> = z.lazy(() => z.object({
+    books: z.array(BookSchema).optional(),
+    next_page_token: z.string().optional(),
+}));
+
+export interface CreateBookRequest {
+    parent?: string;
+    book?: Book;
+}
+
+export function CreateBookRequestFromJSON(json: any): CreateBookRequest {
+    const m: any = {};
+    let v: any;
+    if ((v = json["parent"]) != null) {
+        m.parent = String(v);
+    }
+    if ((v = json["book"]) != null) {
+        m.book = BookFromJSON(v);
+    }
+    return m as CreateBookRequest;
+}
+
+export function CreateBookRequestToJSON(m: CreateBookRequest): any {
+    const json: any = {};
+    if (m.parent !== undefined) {
+        json["parent"] = m.parent;
+    }
+    if (m.book !== undefined) {
+        json["book"] = BookToJSON(m.book);
+    }
+    return json;
+}
+
+export const CreateBookRequestSchema: z.ZodType<CreateBookRequest> = z.lazy(() => z.object({
+    parent: z.string().optional(),
+    book: BookSchema.optional(),
+}));
+
+export interface UpdateBookRequest {
+    book?: Book;
+}
+
+export function UpdateBookRequestFromJSON(json: any): UpdateBookRequest {
+    const m: any = {};
+    let v: any;
+    if ((v = json["book"]) != null) {
+        m.book = BookFromJSON(v);
+    }
+    return m as UpdateBookRequest;
+}
+
+export function UpdateBookRequestToJSON(m: UpdateBookRequest): any {
+    const json: any = {};
+    if (m.book !== undefined) {
+        json["book"] = BookToJSON(m.book);
+    }
+    return json;
+}
+
+export const UpdateBookRequestSchema: z.ZodType<UpdateBookRequest> = z.lazy(() => z.object({
+    book: BookSchema.optional(),
+}));
+
+export interface PublishBookRequest {
+    name?: string;
+    notify?: boolean;
+}
+
+export function PublishBookRequestFromJSON(json: any): PublishBookRequest {
+    const m: any = {};
+    let v: any;
+    if ((v = json["name"]) != null) {
+        m.name = String(v);
+    }
+    if ((v = json["notify"]) != null) {
+        m.notify = boolFromJSON(v);
+    }
+    return m as PublishBookRequest;
+}
+
+export function PublishBookRequestToJSON(m: PublishBookRequest): any {
+    const json: any = {};
+    if (m.name !== undefined) {
+        json["name"] = m.name;
+    }
+    if (m.notify !== undefined) {
+        json["notify"] = m.notify;
+    }
+    return json;
+}
+
+export const PublishBookRequestSchema: z.ZodType<PublishBookRequest> = z.lazy(() => z.object({
+    name: z.string().optional(),
+    notify: z.boolean().optional(),
+}));
+
+export interface LibraryService {
+    GetBook: (r:GetBookRequest) => Book;
+    ListBooks: (r:ListBooksRequest) => ListBooksResponse;
+    CreateBook: (r:CreateBookRequest) => Book;
+    UpdateBook: (r:UpdateBookRequest) => Book;
+    PublishBook: (r:PublishBookRequest) => Book;
+    GetBookTitle: (r:GetBookRequest) => Book;
+    DeleteBook: (r:GetBookRequest) => Empty;
+    WatchBooks: (r:ListBooksRequest, cb:(a:{value: Book, done: boolean}) => void) => void;
+}
+// TypeRegistry maps the type URLs of messages to their types, each file adds
+// its messages.
+declare global {
+    interface TypeRegistry {
+        "type.googleapis.com/library.Book": Book;
+        "type.googleapis.com/library.GetBookRequest": GetBookRequest;
+        "type.googleapis.com/library.ListBooksRequest": ListBooksRequest;
+        "type.googleapis.com/library.ListBooksRequest.Filter": ListBooksRequest_Filter;
+        "type.googleapis.com/library.ListBooksResponse": ListBooksResponse;
+        "type.googleapis.com/library.CreateBookRequest": CreateBookRequest;
+        "type.googleapis.com/library.UpdateBookRequest": UpdateBookRequest;
+        "type.googleapis.com/library.PublishBookRequest": PublishBookRequest;
+    }
+}
+
+function boolFromJSON(json: any): boolean {
+    return json === true || json === "true";
+}
+
+function jsonField(json: any, name: string, jsonName: string): any {
+    return json[name] !== undefined ? json[name] : json[jsonName];
+}
+
+const schemaRegistry: { [typeURL: string]: z.ZodTypeAny } = (globalThis as any).protocGenTSTypesSchemas || ((globalThis as any).protocGenTSTypesSchemas = {});
+
+const typeRegistry: { [typeURL: string]: { fromJSON(json: any): any; toJSON(m: any): any } } = (globalThis as any).protocGenTSTypesRegistry || ((globalThis as any).protocGenTSTypesRegistry = {});
+
+Object.assign(typeRegistry, {
+    "type.googleapis.com/library.Book": { fromJSON: BookFromJSON, toJSON: BookToJSON },
+    "type.googleapis.com/library.GetBookRequest": { fromJSON: GetBookRequestFromJSON, toJSON: GetBookRequestToJSON },
+    "type.googleapis.com/library.ListBooksRequest": { fromJSON: ListBooksRequestFromJSON, toJSON: ListBooksRequestToJSON },
+    "type.googleapis.com/library.ListBooksRequest.Filter": { fromJSON: ListBooksRequest_FilterFromJSON, toJSON: ListBooksRequest_FilterToJSON },
+    "type.googleapis.com/library.ListBooksResponse": { fromJSON: ListBooksResponseFromJSON, toJSON: ListBooksResponseToJSON },
+    "type.googleapis.com/library.CreateBookRequest": { fromJSON: CreateBookRequestFromJSON, toJSON: CreateBookRequestToJSON },
+    "type.googleapis.com/library.UpdateBookRequest": { fromJSON: UpdateBookRequestFromJSON, toJSON: UpdateBookRequestToJSON },
+    "type.googleapis.com/library.PublishBookRequest": { fromJSON: PublishBookRequestFromJSON, toJSON: PublishBookRequestToJSON },
+});
+Object.assign(schemaRegistry, {
+    "type.googleapis.com/library.Book": BookSchema,
+    "type.googleapis.com/library.GetBookRequest": GetBookRequestSchema,
+    "type.googleapis.com/library.ListBooksRequest": ListBooksRequestSchema,
+    "type.googleapis.com/library.ListBooksRequest.Filter": ListBooksRequest_FilterSchema,
+    "type.googleapis.com/library.ListBooksResponse": ListBooksResponseSchema,
+    "type.googleapis.com/library.CreateBookRequest": CreateBookRequestSchema,
+    "type.googleapis.com/library.UpdateBookRequest": UpdateBookRequestSchema,
+    "type.googleapis.com/library.PublishBookRequest": PublishBookRequestSchema,
+});
@@ -0,0 +1,33 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+declare namespace grpc.testing {
+
+    // Unary request.
+    export interface Request {
+        // Whether Response should include username.
+        fill_username?: boolean;
+        // Whether Response should include OAuth scope.
+        fill_oauth_scope?: boolean;
+    }
+
+    // Unary response, as configured by the request.
+    export interface Response {
+        // The user the request came from, for verifying authentication was
+        // successful.
+        username?: string;
+        // OAuth scope.
+        oauth_scope?: string;
+    }
+
+    export interface TestServiceService {
+        UnaryCall: (r:Request) => Response;
+    }
+}
+
+// TypeRegistry maps the type URLs of messages to their types, each file adds
+// its messages.
+interface TypeRegistry {
+    "type.googleapis.com/grpc.testing.Request": grpc.testing.Request;
+    "type.googleapis.com/grpc.testing.Response": grpc.testing.Response;
+}
+
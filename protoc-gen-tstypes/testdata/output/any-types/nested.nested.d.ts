@@ -0,0 +1,45 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+declare namespace nested {
+
+    export enum Notification_Type {
+        UNSPECIFIED = "UNSPECIFIED",
+        TEXT = "TEXT",
+        VIDEO = "VIDEO",
+        AUDIO = "AUDIO",
+    }
+    export interface Notification {
+        message_type?: Notification_Type;
+        content?: string;
+    }
+
+    export enum Tweet_Type {
+        UNSPECIFIED = "UNSPECIFIED",
+        ORIGINAL = "ORIGINAL",
+        RETWEET = "RETWEET",
+    }
+    export interface Tweet {
+        tweet_type?: Tweet_Type;
+        content?: string;
+    }
+
+    export interface A_B {
+        id?: string;
+    }
+
+    export interface A {
+        id?: string;
+        b?: A_B;
+    }
+
+}
+
+// TypeRegistry maps the type URLs of messages to their types, each file adds
+// its messages.
+interface TypeRegistry {
+    "type.googleapis.com/nested.Notification": nested.Notification;
+    "type.googleapis.com/nested.Tweet": nested.Tweet;
+    "type.googleapis.com/nested.A": nested.A;
+    "type.googleapis.com/nested.A.B": nested.A_B;
+}
+
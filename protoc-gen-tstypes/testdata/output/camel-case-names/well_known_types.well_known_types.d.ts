@@ -0,0 +1,34 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+declare namespace well_known_types {
+
+    export interface Values_WrappedEntry {
+        key?: string;
+        value?: google.protobuf.Int32Value;
+    }
+
+    // Values uses each of the well-known types that have a special JSON mapping.
+    export interface Values {
+        timestamp?: google.protobuf.Timestamp;
+        duration?: google.protobuf.Duration;
+        fieldMask?: google.protobuf.FieldMask;
+        struct?: google.protobuf.Struct;
+        value?: google.protobuf.Value;
+        listValue?: google.protobuf.ListValue;
+        any?: google.protobuf.Any;
+        empty?: google.protobuf.Empty;
+        doubleValue?: google.protobuf.DoubleValue;
+        floatValue?: google.protobuf.FloatValue;
+        int64Value?: google.protobuf.Int64Value;
+        uint64Value?: google.protobuf.UInt64Value;
+        int32Value?: google.protobuf.Int32Value;
+        uint32Value?: google.protobuf.UInt32Value;
+        boolValue?: google.protobuf.BoolValue;
+        stringValue?: google.protobuf.StringValue;
+        bytesValue?: google.protobuf.BytesValue;
+        timestamps?: Array<google.protobuf.Timestamp>;
+        wrapped?: { [key: string]: google.protobuf.Int32Value };
+    }
+
+}
+
@@ -0,0 +1,40 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+declare namespace example_with_field_options {
+
+    export enum SearchRequest_Corpus {
+        UNIVERSAL = "UNIVERSAL",
+        WEB = "WEB",
+        IMAGES = "IMAGES",
+        LOCAL = "LOCAL",
+        NEWS = "NEWS",
+        PRODUCTS = "PRODUCTS",
+        VIDEO = "VIDEO",
+    }
+    export interface SearchRequest_XyzEntry {
+        key: string;
+        value: number;
+    }
+
+    // SearchRequest is an example type representing a search query.
+    export interface SearchRequest {
+        query: string;
+        page_number: number;
+        // Number of results per page.
+        result_per_page: number; // Should never be zero.
+        corpus: SearchRequest_Corpus;
+        sent_at?: string;
+        xyz: { [key: string]: number };
+        zytes: Uint8Array;
+        example_required: number;
+    }
+
+    export interface SearchResponse {
+        results: Array<string>;
+        num_results: number;
+        original_request: SearchRequest;
+        next_results_uri: string;
+    }
+
+}
+
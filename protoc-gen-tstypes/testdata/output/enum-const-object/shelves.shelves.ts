@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+import { z } from "zod";
+
+export interface Shelf {
+    name?: string;
+    theme?: string;
+    capacity?: number;
+}
+
+export function ShelfFromJSON(json: any): Shelf {
+    const m: any = {};
+    let v: any;
+    if ((v = json["name"]) != null) {
+        m.name = String(v);
+    }
+    if ((v = json["theme"]) != null) {
+        m.theme = String(v);
+    }
+    if ((v = json["capacity"]) != null) {
+        m.capacity = Number(v);
+    }
+    return m as Shelf;
+}
+
+export function ShelfToJSON(m: Shelf): any {
+    const json: any = {};
+    if (m.name !== undefined) {
+        json["name"] = m.name;
+    }
+    if (m.theme !== undefined) {
+        json["theme"] = m.theme;
+    }
+    if (m.capacity !== undefined) {
+        json["capacity"] = m.capacity;
+    }
+    return json;
+}
+
+export const ShelfSchema: z.ZodType<Shelf> = z.lazy(() => z.object({
+    name: z.string().optional(),
+    theme: z.string().optional(),
+    capacity: z.number().optional(),
+}));
+
+export interface GetShelfRequest {
+    name?: string;
+}
+
+export function GetShelfRequestFromJSON(json: any): GetShelfRequest {
+    const m: any = {};
+    let v: any;
+    if ((v = json["name"]) != null) {
+        m.name = String(v);
+    }
+    return m as GetShelfRequest;
+}
+
+export function GetShelfRequestToJSON(m: GetShelfRequest): any {
+    const json: any = {};
+    if (m.name !== undefined) {
+        json["name"] = m.name;
+    }
+    return json;
+}
+
+export const GetShelfRequestSchema: z.ZodType<GetShelfRequest> = z.lazy(() => z.object({
+    name: z.string().optional(),
+}));
+
+export interface AddBookRequest {
+    shelf?: string;
+    book?: string;
+}
+
+export function AddBookRequestFromJSON(json: any): AddBookRequest {
+    const m: any = {};
+    let v: any;
+    if ((v = json["shelf"]) != null) {
+        m.shelf = String(v);
+    }
+    if ((v = json["book"]) != null) {
+        m.book = String(v);
+    }
+    return m as AddBookRequest;
+}
+
+export function AddBookRequestToJSON(m: AddBookRequest): any {
+    const json: any = {};
+    if (m.shelf !== undefined) {
+        json["shelf"] = m.shelf;
+    }
+    if (m.book !== undefined) {
+        json["book"] = m.book;
+    }
+    return json;
+}
+
+export const AddBookRequestSchema: z.ZodType<AddBookRequest> = z.lazy(() => z.object({
+    shelf: z.string().optional(),
+    book: z.string().optional(),
+}));
+
+// ShelfFull is a google.rpc.Status detail of AddBook errors.
+export interface ShelfFull {
+    shelf?: string;
+    capacity?: number;
+}
+
+export function ShelfFullFromJSON(json: any): ShelfFull {
+    const m: any = {};
+    let v: any;
+    if ((v = json["shelf"]) != null) {
+        m.shelf = String(v);
+    }
+    if ((v = json["capacity"]) != null) {
+        m.capacity = Number(v);
+    }
+    return m as ShelfFull;
+}
+
+export function ShelfFullToJSON(m: ShelfFull): any {
+    const json: any = {};
+    if (m.shelf !== undefined) {
+        json["shelf"] = m.shelf;
+    }
+    if (m.capacity !== undefined) {
+        json["capacity"] = m.capacity;
+    }
+    return json;
+}
+
+export const ShelfFullSchema: z.ZodType<ShelfFull> = z.lazy(() => z.object({
+    shelf: z.string().optional(),
+    capacity: z.number().optional(),
+}));
+
+export interface ShelvesService {
+    GetShelf: (r:GetShelfRequest) => Shelf;
+    AddBook: (r:AddBookRequest) => Shelf;
+}
@@ -0,0 +1,27 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+declare namespace nested {
+
+    export type Notification_Type = "TEXT" | "VIDEO" | "AUDIO";
+    export interface Notification {
+        message_type?: Notification_Type;
+        content?: string;
+    }
+
+    export type Tweet_Type = "ORIGINAL" | "RETWEET";
+    export interface Tweet {
+        tweet_type?: Tweet_Type;
+        content?: string;
+    }
+
+    export interface A_B {
+        id?: string;
+    }
+
+    export interface A {
+        id?: string;
+        b?: A_B;
+    }
+
+}
+
@@ -0,0 +1,34 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+declare namespace well_known_types {
+
+    export interface Values_WrappedEntry {
+        key?: string;
+        value?: number | null;
+    }
+
+    // Values uses each of the well-known types that have a special JSON mapping.
+    export interface Values {
+        timestamp?: string;
+        duration?: string;
+        field_mask?: string;
+        struct?: { [key: string]: any };
+        value?: any;
+        list_value?: Array<any>;
+        any?: { "@type": string; [key: string]: any };
+        empty?: {};
+        double_value?: number | null;
+        float_value?: number | null;
+        int64_value?: number | null;
+        uint64_value?: number | null;
+        int32_value?: number | null;
+        uint32_value?: number | null;
+        bool_value?: boolean | null;
+        string_value?: string | null;
+        bytes_value?: string | null;
+        timestamps?: Array<string>;
+        wrapped?: { [key: string]: number | null };
+    }
+
+}
+
@@ -0,0 +1,34 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+declare namespace well_known_types {
+
+    export interface Values_WrappedEntry {
+        key?: string;
+        value?: google.protobuf.Int32Value;
+    }
+
+    // Values uses each of the well-known types that have a special JSON mapping.
+    export interface Values {
+        timestamp?: google.protobuf.Timestamp;
+        duration?: google.protobuf.Duration;
+        field_mask?: google.protobuf.FieldMask;
+        struct?: google.protobuf.Struct;
+        value?: google.protobuf.Value;
+        list_value?: google.protobuf.ListValue;
+        any?: google.protobuf.Any;
+        empty?: google.protobuf.Empty;
+        double_value?: google.protobuf.DoubleValue;
+        float_value?: google.protobuf.FloatValue;
+        int64_value?: google.protobuf.Int64Value;
+        uint64_value?: google.protobuf.UInt64Value;
+        int32_value?: google.protobuf.Int32Value;
+        uint32_value?: google.protobuf.UInt32Value;
+        bool_value?: google.protobuf.BoolValue;
+        string_value?: google.protobuf.StringValue;
+        bytes_value?: google.protobuf.BytesValue;
+        timestamps?: Array<google.protobuf.Timestamp>;
+        wrapped?: { [key: string]: google.protobuf.Int32Value };
+    }
+
+}
+
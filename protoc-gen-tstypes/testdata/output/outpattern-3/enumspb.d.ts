@@ -0,0 +1,31 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+declare namespace enums {
+
+    export enum Color {
+        COLOR_UNSPECIFIED = "COLOR_UNSPECIFIED",
+        COLOR_RED = "COLOR_RED",
+        COLOR_GREEN = "COLOR_GREEN",
+    }
+    export enum Digits {
+        DIGITS_UNSPECIFIED = "DIGITS_UNSPECIFIED",
+        DIGITS_1 = "DIGITS_1",
+    }
+    export enum Paint_HTTPMethod {
+        HTTP_METHOD_UNSPECIFIED = "HTTP_METHOD_UNSPECIFIED",
+        HTTP_METHOD_GET = "HTTP_METHOD_GET",
+    }
+    export interface Paint_DigitsEntry {
+        key?: string;
+        value?: Digits;
+    }
+
+    export interface Paint {
+        color?: Color;
+        mix?: Array<Color>;
+        method?: Paint_HTTPMethod;
+        digits?: { [key: string]: Digits };
+    }
+
+}
+
@@ -0,0 +1,435 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+export interface RetryInfo {
+    retry_delay?: string;
+}
+
+export function RetryInfoFromJSON(json: any): RetryInfo {
+    const m: any = {};
+    let v: any;
+    if ((v = jsonField(json, "retry_delay", "retryDelay")) != null) {
+        m.retry_delay = String(v);
+    }
+    return m as RetryInfo;
+}
+
+export function RetryInfoToJSON(m: RetryInfo): any {
+    const json: any = {};
+    if (m.retry_delay !== undefined) {
+        json["retry_delay"] = m.retry_delay;
+    }
+    return json;
+}
+
+export interface DebugInfo {
+    stack_entries?: Array<string>;
+    detail?: string;
+}
+
+export function DebugInfoFromJSON(json: any): DebugInfo {
+    const m: any = {};
+    let v: any;
+    if ((v = jsonField(json, "stack_entries", "stackEntries")) != null) {
+        m.stack_entries = v.map((e: any) => String(e));
+    }
+    if ((v = json["detail"]) != null) {
+        m.detail = String(v);
+    }
+    return m as DebugInfo;
+}
+
+export function DebugInfoToJSON(m: DebugInfo): any {
+    const json: any = {};
+    if (m.stack_entries !== undefined) {
+        json["stack_entries"] = m.stack_entries.map((e: any) => e);
+    }
+    if (m.detail !== undefined) {
+        json["detail"] = m.detail;
+    }
+    return json;
+}
+
+export interface QuotaFailure_Violation {
+    subject?: string;
+    description?: string;
+}
+
+export function QuotaFailure_ViolationFromJSON(json: any): QuotaFailure_Violation {
+    const m: any = {};
+    let v: any;
+    if ((v = json["subject"]) != null) {
+        m.subject = String(v);
+    }
+    if ((v = json["description"]) != null) {
+        m.description = String(v);
+    }
+    return m as QuotaFailure_Violation;
+}
+
+export function QuotaFailure_ViolationToJSON(m: QuotaFailure_Violation): any {
+    const json: any = {};
+    if (m.subject !== undefined) {
+        json["subject"] = m.subject;
+    }
+    if (m.description !== undefined) {
+        json["description"] = m.description;
+    }
+    return json;
+}
+
+export interface QuotaFailure {
+    violations?: Array<QuotaFailure_Violation>;
+}
+
+export function QuotaFailureFromJSON(json: any): QuotaFailure {
+    const m: any = {};
+    let v: any;
+    if ((v = json["violations"]) != null) {
+        m.violations = v.map((e: any) => QuotaFailure_ViolationFromJSON(e));
+    }
+    return m as QuotaFailure;
+}
+
+export function QuotaFailureToJSON(m: QuotaFailure): any {
+    const json: any = {};
+    if (m.violations !== undefined) {
+        json["violations"] = m.violations.map((e: any) => QuotaFailure_ViolationToJSON(e));
+    }
+    return json;
+}
+
+export interface ErrorInfo_MetadataEntry {
+    key?: string;
+    value?: string;
+}
+
+export function ErrorInfo_MetadataEntryFromJSON(json: any): ErrorInfo_MetadataEntry {
+    const m: any = {};
+    let v: any;
+    if ((v = json["key"]) != null) {
+        m.key = String(v);
+    }
+    if ((v = json["value"]) != null) {
+        m.value = String(v);
+    }
+    return m as ErrorInfo_MetadataEntry;
+}
+
+export function ErrorInfo_MetadataEntryToJSON(m: ErrorInfo_MetadataEntry): any {
+    const json: any = {};
+    if (m.key !== undefined) {
+        json["key"] = m.key;
+    }
+    if (m.value !== undefined) {
+        json["value"] = m.value;
+    }
+    return json;
+}
+
+export interface ErrorInfo {
+    reason?: string;
+    domain?: string;
+    metadata?: { [key: string]: string };
+}
+
+export function ErrorInfoFromJSON(json: any): ErrorInfo {
+    const m: any = {};
+    let v: any;
+    if ((v = json["reason"]) != null) {
+        m.reason = String(v);
+    }
+    if ((v = json["domain"]) != null) {
+        m.domain = String(v);
+    }
+    if ((v = json["metadata"]) != null) {
+        m.metadata = mapFromJSON(v, (e: any) => String(e));
+    }
+    return m as ErrorInfo;
+}
+
+export function ErrorInfoToJSON(m: ErrorInfo): any {
+    const json: any = {};
+    if (m.reason !== undefined) {
+        json["reason"] = m.reason;
+    }
+    if (m.domain !== undefined) {
+        json["domain"] = m.domain;
+    }
+    if (m.metadata !== undefined) {
+        json["metadata"] = mapToJSON(m.metadata, (e: any) => e);
+    }
+    return json;
+}
+
+export interface PreconditionFailure_Violation {
+    type?: string;
+    subject?: string;
+    description?: string;
+}
+
+export function PreconditionFailure_ViolationFromJSON(json: any): PreconditionFailure_Violation {
+    const m: any = {};
+    let v: any;
+    if ((v = json["type"]) != null) {
+        m.type = String(v);
+    }
+    if ((v = json["subject"]) != null) {
+        m.subject = String(v);
+    }
+    if ((v = json["description"]) != null) {
+        m.description = String(v);
+    }
+    return m as PreconditionFailure_Violation;
+}
+
+export function PreconditionFailure_ViolationToJSON(m: PreconditionFailure_Violation): any {
+    const json: any = {};
+    if (m.type !== undefined) {
+        json["type"] = m.type;
+    }
+    if (m.subject !== undefined) {
+        json["subject"] = m.subject;
+    }
+    if (m.description !== undefined) {
+        json["description"] = m.description;
+    }
+    return json;
+}
+
+export interface PreconditionFailure {
+    violations?: Array<PreconditionFailure_Violation>;
+}
+
+export function PreconditionFailureFromJSON(json: any): PreconditionFailure {
+    const m: any = {};
+    let v: any;
+    if ((v = json["violations"]) != null) {
+        m.violations = v.map((e: any) => PreconditionFailure_ViolationFromJSON(e));
+    }
+    return m as PreconditionFailure;
+}
+
+export function PreconditionFailureToJSON(m: PreconditionFailure): any {
+    const json: any = {};
+    if (m.violations !== undefined) {
+        json["violations"] = m.violations.map((e: any) => PreconditionFailure_ViolationToJSON(e));
+    }
+    return json;
+}
+
+export interface BadRequest_FieldViolation {
+    field?: string;
+    description?: string;
+}
+
+export function BadRequest_FieldViolationFromJSON(json: any): BadRequest_FieldViolation {
+    const m: any = {};
+    let v: any;
+    if ((v = json["field"]) != null) {
+        m.field = String(v);
+    }
+    if ((v = json["description"]) != null) {
+        m.description = String(v);
+    }
+    return m as BadRequest_FieldViolation;
+}
+
+export function BadRequest_FieldViolationToJSON(m: BadRequest_FieldViolation): any {
+    const json: any = {};
+    if (m.field !== undefined) {
+        json["field"] = m.field;
+    }
+    if (m.description !== undefined) {
+        json["description"] = m.description;
+    }
+    return json;
+}
+
+export interface BadRequest {
+    field_violations?: Array<BadRequest_FieldViolation>;
+}
+
+export function BadRequestFromJSON(json: any): BadRequest {
+    const m: any = {};
+    let v: any;
+    if ((v = jsonField(json, "field_violations", "fieldViolations")) != null) {
+        m.field_violations = v.map((e: any) => BadRequest_FieldViolationFromJSON(e));
+    }
+    return m as BadRequest;
+}
+
+export function BadRequestToJSON(m: BadRequest): any {
+    const json: any = {};
+    if (m.field_violations !== undefined) {
+        json["field_violations"] = m.field_violations.map((e: any) => BadRequest_FieldViolationToJSON(e));
+    }
+    return json;
+}
+
+export interface RequestInfo {
+    request_id?: string;
+    serving_data?: string;
+}
+
+export function RequestInfoFromJSON(json: any): RequestInfo {
+    const m: any = {};
+    let v: any;
+    if ((v = jsonField(json, "request_id", "requestId")) != null) {
+        m.request_id = String(v);
+    }
+    if ((v = jsonField(json, "serving_data", "servingData")) != null) {
+        m.serving_data = String(v);
+    }
+    return m as RequestInfo;
+}
+
+export function RequestInfoToJSON(m: RequestInfo): any {
+    const json: any = {};
+    if (m.request_id !== undefined) {
+        json["request_id"] = m.request_id;
+    }
+    if (m.serving_data !== undefined) {
+        json["serving_data"] = m.serving_data;
+    }
+    return json;
+}
+
+export interface ResourceInfo {
+    resource_type?: string;
+    resource_name?: string;
+    owner?: string;
+    description?: string;
+}
+
+export function ResourceInfoFromJSON(json: any): ResourceInfo {
+    const m: any = {};
+    let v: any;
+    if ((v = jsonField(json, "resource_type", "resourceType")) != null) {
+        m.resource_type = String(v);
+    }
+    if ((v = jsonField(json, "resource_name", "resourceName")) != null) {
+        m.resource_name = String(v);
+    }
+    if ((v = json["owner"]) != null) {
+        m.owner = String(v);
+    }
+    if ((v = json["description"]) != null) {
+        m.description = String(v);
+    }
+    return m as ResourceInfo;
+}
+
+export function ResourceInfoToJSON(m: ResourceInfo): any {
+    const json: any = {};
+    if (m.resource_type !== undefined) {
+        json["resource_type"] = m.resource_type;
+    }
+    if (m.resource_name !== undefined) {
+        json["resource_name"] = m.resource_name;
+    }
+    if (m.owner !== undefined) {
+        json["owner"] = m.owner;
+    }
+    if (m.description !== undefined) {
+        json["description"] = m.description;
+    }
+    return json;
+}
+
+export interface Help_Link {
+    description?: string;
+    url?: string;
+}
+
+export function Help_LinkFromJSON(json: any): Help_Link {
+    const m: any = {};
+    let v: any;
+    if ((v = json["description"]) != null) {
+        m.description = String(v);
+    }
+    if ((v = json["url"]) != null) {
+        m.url = String(v);
+    }
+    return m as Help_Link;
+}
+
+export function Help_LinkToJSON(m: Help_Link): any {
+    const json: any = {};
+    if (m.description !== undefined) {
+        json["description"] = m.description;
+    }
+    if (m.url !== undefined) {
+        json["url"] = m.url;
+    }
+    return json;
+}
+
+export interface Help {
+    links?: Array<Help_Link>;
+}
+
+export function HelpFromJSON(json: any): Help {
+    const m: any = {};
+    let v: any;
+    if ((v = json["links"]) != null) {
+        m.links = v.map((e: any) => Help_LinkFromJSON(e));
+    }
+    return m as Help;
+}
+
+export function HelpToJSON(m: Help): any {
+    const json: any = {};
+    if (m.links !== undefined) {
+        json["links"] = m.links.map((e: any) => Help_LinkToJSON(e));
+    }
+    return json;
+}
+
+export interface LocalizedMessage {
+    locale?: string;
+    message?: string;
+}
+
+export function LocalizedMessageFromJSON(json: any): LocalizedMessage {
+    const m: any = {};
+    let v: any;
+    if ((v = json["locale"]) != null) {
+        m.locale = String(v);
+    }
+    if ((v = json["message"]) != null) {
+        m.message = String(v);
+    }
+    return m as LocalizedMessage;
+}
+
+export function LocalizedMessageToJSON(m: LocalizedMessage): any {
+    const json: any = {};
+    if (m.locale !== undefined) {
+        json["locale"] = m.locale;
+    }
+    if (m.message !== undefined) {
+        json["message"] = m.message;
+    }
+    return json;
+}
+
+function jsonField(json: any, name: string, jsonName: string): any {
+    return json[name] !== undefined ? json[name] : json[jsonName];
+}
+
+function mapFromJSON<T>(json: any, f: (v: any) => T): { [key: string]: T } {
+    const m: { [key: string]: T } = {};
+    for (const k of Object.keys(json)) {
+        m[k] = f(json[k]);
+    }
+    return m;
+}
+
+function mapToJSON<T>(m: { [key: string]: T }, f: (v: T) => any): { [key: string]: any } {
+    const json: { [key: string]: any } = {};
+    for (const k of Object.keys(m)) {
+        json[k] = f(m[k]);
+    }
+    return json;
+}
+
@@ -0,0 +1,96 @@
+// Code generated by protoc-gen-tstypes. DO NOT EDIT.
+
+import { z } from "zod";
+import type { Empty } from "./google/protobuf/google.protobuf.empty";
+
+export interface Book {
+    name?: string;
+    title?: string;
+    authors?: Array<string>;
+}
+
+export const BookSchema: z.ZodType<Book> = z.lazy(() => z.object({
+    name: z.string().optional(),
+    title: z.string().optional(),
+    authors: z.array(z.string()).optional(),
+}));
+
+export interface GetBookRequest {
+    // Resource name of the book, e.g. "shelves/1/books/2".
+    name?: string;
+}
+
+export const GetBookRequestSchema: z.ZodType<GetBookRequest> = z.lazy(() => z.object({
+    name: z.string().optional(),
+}));
+
+export interface ListBooksRequest_Filter {
+    author?: string;
+}
+
+export const ListBooksRequest_FilterSchema: z.ZodType<ListBooksRequest_Filter> = z.lazy(() => z.object({
+    author: z.string().optional(),
+}));
+
+export interface ListBooksRequest {
+    parent?: string;
+    page_size?: number;
+    page_token?: string;
+    filter?: ListBooksRequest_Filter;
+}
+
+export const ListBooksRequestSchema: z.ZodType<ListBooksRequest> = z.lazy(() => z.object({
+    parent: z.string().optional(),
+    page_size: z.number().optional(),
+    page_token: z.string().optional(),
+    filter: ListBooksRequest_FilterSchema.optional(),
+}));
+
+export interface ListBooksResponse {
+    books?: Array<Book>;
+    next_page_token?: string;
+}
+
+export const ListBooksResponseSchema: z.ZodType<ListBooksResponse> = z.lazy(() => z.object({
+    books: z.array(BookSchema).optional(),
+    next_page_token: z.string().optional(),
+}));
+
+export interface CreateBookRequest {
+    parent?: string;
+    book?: Book;
+}
+
+export const CreateBookRequestSchema: z.ZodType<CreateBookRequest> = z.lazy(() => z.object({
+    parent: z.string().optional(),
+    book: BookSchema.optional(),
+}));
+
+export interface UpdateBookRequest {
+    book?: Book;
+}
+
+export const UpdateBookRequestSchema: z.ZodType<UpdateBookRequest> = z.lazy(() => z.object({
+    book: BookSchema.optional(),
+}));
+
+export interface PublishBookRequest {
+    name?: string;
+    notify?: boolean;
+}
+
+export const PublishBookRequestSchema: z.ZodType<PublishBookRequest> = z.lazy(() => z.object({
+    name: z.string().optional(),
+    notify: z.boolean().optional(),
+}));
+
+export interface LibraryService {
+    GetBook: (r:GetBookRequest) => Book;
+    ListBooks: (r:ListBooksRequest) => ListBooksResponse;
+    CreateBook: (r:CreateBookRequest) => Book;
+    UpdateBook: (r:UpdateBookRequest) => Book;
+    PublishBook: (r:PublishBookRequest) => Book;
+    GetBookTitle: (r:GetBookRequest) => Book;
+    DeleteBook: (r:GetBookRequest) => Empty;
+    WatchBooks: (r:ListBooksRequest, cb:(a:{value: Book, done: boolean}) => void) => void;
+}